@@ -0,0 +1,185 @@
+/*
+MIT License
+
+Copyright (c) 2023 Mohammad Shafiee <muhammad.shafiee@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+
+package jalali
+
+import (
+	"errors"
+	"strings"
+	"time"
+)
+
+// Calendar identifies the calendar a date string is written in.
+type Calendar int
+
+const (
+	// CalendarJalali is the Jalali (Solar Hijri) calendar.
+	CalendarJalali Calendar = iota + 1
+	// CalendarGregorian is the Gregorian calendar.
+	CalendarGregorian
+)
+
+// String returns the English name of the calendar.
+func (c Calendar) String() string {
+	switch c {
+	case CalendarJalali:
+		return "Jalali"
+	case CalendarGregorian:
+		return "Gregorian"
+	}
+	return "Unknown"
+}
+
+// CalendarGuess is the result of ParseAnyCalendar.
+type CalendarGuess struct {
+	Time       JalaliTime // the value, converted to the Jalali calendar if it was Gregorian
+	Calendar   Calendar   // the calendar the value is taken to be written in
+	Layout     string     // the layout that matched
+	Confidence float64    // between 0.5 and 1; how strongly the evidence favours Calendar
+	Ambiguous  bool       // the value is a plausible date in both calendars
+}
+
+// Years outside these ranges are unlikely in real data and count as evidence
+// against the calendar.
+const (
+	minPlausibleJalaliYear    = 1200
+	maxPlausibleJalaliYear    = 1500
+	minPlausibleGregorianYear = 1800
+	maxPlausibleGregorianYear = 2200
+)
+
+// gregorianMonthNames contains the Gregorian month names accepted when reading
+// a Gregorian date, in English and Persian, indexed by time.Month.
+var gregorianMonthNames = [][]string{
+	nil,
+	{"January", "Jan", "ژانویه", "ژانویهٔ"},
+	{"February", "Feb", "فوریه", "فوریهٔ"},
+	{"March", "Mar", "مارس", "مارچ"},
+	{"April", "Apr", "آوریل", "آپریل"},
+	{"May", "مه", "می"},
+	{"June", "Jun", "ژوئن", "جون"},
+	{"July", "Jul", "ژوئیه", "جولای"},
+	{"August", "Aug", "اوت", "آگوست"},
+	{"September", "Sept", "Sep", "سپتامبر"},
+	{"October", "Oct", "اکتبر"},
+	{"November", "Nov", "نوامبر"},
+	{"December", "Dec", "دسامبر"},
+}
+
+// ParseAnyCalendar parses a date that may be written in the Jalali or the
+// Gregorian calendar in the local time zone. See AnyParser.ParseCalendar.
+func ParseAnyCalendar(value string) (CalendarGuess, error) {
+	return (&AnyParser{}).ParseCalendar(value, time.Local)
+}
+
+// ParseAnyCalendarInLocation is like ParseAnyCalendar but interprets the value
+// in the given location.
+func ParseAnyCalendarInLocation(value string, loc *time.Location) (CalendarGuess, error) {
+	return (&AnyParser{}).ParseCalendar(value, loc)
+}
+
+// ParseCalendar parses value with the parser's layouts once as a Jalali date and
+// once as a Gregorian date, and decides which calendar it is written in:
+//
+//   - month names belong to one calendar, so "20 Mordad 1402" is Jalali and
+//     "11 August 2023" is Gregorian;
+//   - a year between 1200 and 1500 suggests the Jalali calendar, and a year
+//     between 1800 and 2200 the Gregorian calendar;
+//   - Persian or Arabic-Indic digits suggest the Jalali calendar.
+//
+// The returned Time is always in the Jalali calendar. When the evidence is
+// balanced, Ambiguous is set and the Jalali reading is returned with a
+// confidence of 0.5; callers importing data should not convert such values
+// silently. If the value reads as neither, the error is the one from the Jalali
+// reading, or an error wrapping ErrAmbiguousDate if the day and month order is
+// ambiguous.
+func (p *AnyParser) ParseCalendar(value string, loc *time.Location) (CalendarGuess, error) {
+	j, jLayout, jErr := p.Parse(value, loc)
+	g, gLayout, gErr := p.parse(value, loc, true)
+	if jErr != nil && gErr != nil {
+		if errors.Is(gErr, ErrAmbiguousDate) {
+			return CalendarGuess{}, gErr
+		}
+		return CalendarGuess{}, jErr
+	}
+
+	var jScore, gScore int
+	if jErr == nil && plausibleJalaliYear(j.year) {
+		jScore += 2
+	}
+	if gErr == nil && plausibleGregorianYear(g.ToGregorian().Year()) {
+		gScore += 2
+	}
+	if jErr == nil && hasNonASCIIDigits(value) {
+		jScore++
+	}
+
+	switch {
+	case gErr != nil:
+		return CalendarGuess{Time: j, Calendar: CalendarJalali, Layout: jLayout, Confidence: confidence(jScore, 0)}, nil
+	case jErr != nil:
+		return CalendarGuess{Time: g, Calendar: CalendarGregorian, Layout: gLayout, Confidence: confidence(gScore, 0)}, nil
+	case gScore > jScore:
+		return CalendarGuess{Time: g, Calendar: CalendarGregorian, Layout: gLayout, Confidence: confidence(gScore, jScore)}, nil
+	case jScore > gScore:
+		return CalendarGuess{Time: j, Calendar: CalendarJalali, Layout: jLayout, Confidence: confidence(jScore, gScore)}, nil
+	}
+	return CalendarGuess{Time: j, Calendar: CalendarJalali, Layout: jLayout, Confidence: 0.5, Ambiguous: true}, nil
+}
+
+// confidence returns how strongly a score of win favours a calendar over a
+// score of lose for the other calendar. A calendar that is the only reading
+// but has no evidence of its own gets 0.6.
+func confidence(win, lose int) float64 {
+	if win == 0 {
+		return 0.6
+	}
+	return 0.5 + 0.45*float64(win-lose)/float64(win+lose)
+}
+
+// plausibleJalaliYear reports whether year is likely as a Jalali year in real data.
+func plausibleJalaliYear(year int) bool {
+	return year >= minPlausibleJalaliYear && year < maxPlausibleJalaliYear
+}
+
+// plausibleGregorianYear reports whether year is likely as a Gregorian year in real data.
+func plausibleGregorianYear(year int) bool {
+	return year >= minPlausibleGregorianYear && year < maxPlausibleGregorianYear
+}
+
+// hasNonASCIIDigits reports whether s contains Persian or Arabic-Indic digits.
+func hasNonASCIIDigits(s string) bool {
+	return strings.IndexFunc(s, func(r rune) bool {
+		return r >= '۰' && r <= '۹' || r >= '٠' && r <= '٩'
+	}) >= 0
+}
+
+// isValidGregorianDate reports whether year, month and day name a day of the
+// Gregorian calendar.
+func isValidGregorianDate(year, month, day int) bool {
+	if month < 1 || month > 12 || day < 1 {
+		return false
+	}
+	return day <= time.Date(year, time.Month(month)+1, 0, 0, 0, 0, 0, time.UTC).Day()
+}
@@ -0,0 +1,87 @@
+/*
+MIT License
+
+Copyright (c) 2023 Mohammad Shafiee <muhammad.shafiee@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+
+package jalali
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestParseAnyCalendar(t *testing.T) {
+	date := Date(1402, Mordad, 20, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		value      string
+		calendar   Calendar
+		confidence float64
+		ambiguous  bool
+		want       JalaliTime
+	}{
+		{"1402/05/20", CalendarJalali, 0.95, false, date},
+		{"2023/08/11", CalendarGregorian, 0.95, false, date},
+		{"۱۴۰۲/۰۵/۲۰", CalendarJalali, 0.95, false, date},
+		{"۲۰۲۳/۰۸/۱۱", CalendarGregorian, 0.65, false, date},
+		{"11/23/2023", CalendarGregorian, 0.95, false, Date(1402, Azar, 2, 0, 0, 0, 0, time.UTC)},
+		{"20 Mordad 1402", CalendarJalali, 0.95, false, date},
+		{"11 August 2023", CalendarGregorian, 0.95, false, date},
+		{"۱۱ اوت ۲۰۲۳", CalendarGregorian, 0.95, false, date},
+		{"August 11, 2023", CalendarGregorian, 0.95, false, date},
+		{"2300/01/01", CalendarJalali, 0.5, true, Date(2300, Farvardin, 1, 0, 0, 0, 0, time.UTC)},
+	}
+
+	for _, tc := range tests {
+		got, err := ParseAnyCalendarInLocation(tc.value, time.UTC)
+		if err != nil {
+			t.Errorf("ParseAnyCalendarInLocation(%q) error = %v", tc.value, err)
+			continue
+		}
+		if got.Time != tc.want || got.Calendar != tc.calendar || got.Ambiguous != tc.ambiguous ||
+			!approxEqual(got.Confidence, tc.confidence) {
+			t.Errorf("ParseAnyCalendarInLocation(%q) = %v %v %.2f ambiguous=%v, want %v %v %.2f ambiguous=%v",
+				tc.value, got.Time, got.Calendar, got.Confidence, got.Ambiguous,
+				tc.want, tc.calendar, tc.confidence, tc.ambiguous)
+		}
+	}
+}
+
+func TestParseAnyCalendarErrors(t *testing.T) {
+	if _, err := ParseAnyCalendarInLocation("not a date", time.UTC); err == nil {
+		t.Error("ParseAnyCalendarInLocation() error = nil, want an error")
+	}
+	if _, err := ParseAnyCalendarInLocation("05/06/2023", time.UTC); !errors.Is(err, ErrAmbiguousDate) {
+		t.Errorf("ParseAnyCalendarInLocation() error = %v, want ErrAmbiguousDate", err)
+	}
+
+	p := &AnyParser{Order: DayFirst}
+	got, err := p.ParseCalendar("05/06/2023", time.UTC)
+	if err != nil || got.Calendar != CalendarGregorian || got.Time != Date(1402, Khordad, 15, 0, 0, 0, 0, time.UTC) {
+		t.Errorf("ParseCalendar() with DayFirst = %+v, %v", got, err)
+	}
+}
+
+func approxEqual(a, b float64) bool {
+	return a-b < 1e-9 && b-a < 1e-9
+}
@@ -0,0 +1,324 @@
+/*
+MIT License
+
+Copyright (c) 2023 Mohammad Shafiee <muhammad.shafiee@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+
+// Package csvconv converts date columns of CSV data between the Gregorian and
+// Jalali calendars. It reads and writes one record at a time, so it handles
+// exports of any size in constant memory.
+package csvconv
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/mshafiee/jalali"
+)
+
+// Direction is the direction in which a column is converted.
+type Direction int
+
+const (
+	// ToJalali converts Gregorian times to Jalali times.
+	ToJalali Direction = iota + 1
+
+	// ToGregorian converts Jalali times to Gregorian times.
+	ToGregorian
+)
+
+// Column describes a column to convert. Gregorian values are parsed and
+// formatted with the layouts of the time package, such as "2006-01-02", and
+// Jalali values with the layouts of jalali.ParseInLocation and
+// jalali.JalaliTime.Format, such as "%Y/%m/%d".
+type Column struct {
+	// Index is the zero-based index of the column. It is ignored if Name is set.
+	Index int
+
+	// Name is the name of the column in the header record. It requires
+	// Converter.Header.
+	Name string
+
+	Direction Direction
+
+	// InputLayout is the layout of the values read, and OutputLayout the
+	// layout of the values written.
+	InputLayout  string
+	OutputLayout string
+
+	// Location is the location of input values without a zone. If it is nil,
+	// UTC is used.
+	Location *time.Location
+
+	// Locale is the locale of the Jalali month and weekday names. If it is
+	// nil, jalali.LocalePersian is used.
+	Locale *jalali.Locale
+}
+
+// Converter converts the columns of CSV records.
+type Converter struct {
+	Columns []Column
+
+	// Header reports whether the first record is a header, which is copied
+	// unchanged and used to find columns by name.
+	Header bool
+
+	// Comma is the field delimiter of both input and output. If it is 0, ','
+	// is used.
+	Comma rune
+
+	// Rejects receives the records that fail to convert, as CSV records of
+	// their line number, the error and their original fields. If it is nil,
+	// Convert stops at the first such record and returns its *RowError.
+	Rejects io.Writer
+}
+
+// Stats counts the records converted by Convert, not counting the header.
+type Stats struct {
+	Records  int // records written to the output
+	Rejected int // records written to Rejects
+}
+
+// RowError is the error for a value that could not be converted.
+type RowError struct {
+	Line  int // line of the record in the input, starting at 1
+	Field int // field of the value in the record, starting at 1
+	Value string
+	Err   error
+}
+
+func (e *RowError) Error() string {
+	return fmt.Sprintf("line %d, field %d: cannot convert %q: %v", e.Line, e.Field, e.Value, e.Err)
+}
+
+func (e *RowError) Unwrap() error {
+	return e.Err
+}
+
+// ErrMissingField is the error of a RowError for a record that is too short
+// to have the field of a column.
+var ErrMissingField = errors.New("missing field")
+
+// ErrOutOfRange is the error of a RowError for a time before March 20, 1600,
+// Farvardin 1, 979, the earliest time the Jalali conversion supports, in either
+// direction.
+var ErrOutOfRange = errors.New("time out of the range of the Jalali calendar")
+
+// minTime and minYear are the earliest Gregorian time and Jalali year that
+// convert correctly.
+var minTime = time.Date(1600, time.March, 20, 0, 0, 0, 0, time.UTC)
+
+const minYear = 979
+
+// Convert reads CSV records from src and writes them to dst with the values
+// of c.Columns converted. Empty values are written unchanged. Errors in the
+// CSV syntax of src stop the conversion.
+func (c *Converter) Convert(dst io.Writer, src io.Reader) (Stats, error) {
+	var stats Stats
+
+	r := csv.NewReader(src)
+	r.FieldsPerRecord = -1
+	r.ReuseRecord = true
+	w := csv.NewWriter(dst)
+	var rejects *csv.Writer
+	if c.Rejects != nil {
+		rejects = csv.NewWriter(c.Rejects)
+	}
+	if c.Comma != 0 {
+		r.Comma, w.Comma = c.Comma, c.Comma
+		if rejects != nil {
+			rejects.Comma = c.Comma
+		}
+	}
+
+	columns := c.Columns
+	if c.Header {
+		header, err := r.Read()
+		if err == io.EOF {
+			return stats, nil
+		}
+		if err != nil {
+			return stats, err
+		}
+		if columns, err = c.resolve(header); err != nil {
+			return stats, err
+		}
+		if err := w.Write(header); err != nil {
+			return stats, err
+		}
+		if rejects != nil {
+			if err := rejects.Write(append([]string{"line", "error"}, header...)); err != nil {
+				return stats, err
+			}
+		}
+	} else if err := c.validate(); err != nil {
+		return stats, err
+	}
+
+	var out []string
+	for {
+		record, err := r.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return stats, c.flush(w, rejects, err)
+		}
+		line, _ := r.FieldPos(0)
+
+		out = append(out[:0], record...)
+		if rerr := convert(out, columns, line); rerr != nil {
+			if rejects == nil {
+				return stats, c.flush(w, rejects, rerr)
+			}
+			reject := append([]string{strconv.Itoa(rerr.Line), rerr.Error()}, record...)
+			if err := rejects.Write(reject); err != nil {
+				return stats, err
+			}
+			stats.Rejected++
+			continue
+		}
+
+		if err := w.Write(out); err != nil {
+			return stats, err
+		}
+		stats.Records++
+	}
+	return stats, c.flush(w, rejects, nil)
+}
+
+// flush flushes the output writers and returns err, or the first error of
+// the writers.
+func (c *Converter) flush(w, rejects *csv.Writer, err error) error {
+	w.Flush()
+	if err == nil {
+		err = w.Error()
+	}
+	if rejects != nil {
+		rejects.Flush()
+		if err == nil {
+			err = rejects.Error()
+		}
+	}
+	return err
+}
+
+// convert converts the values of columns in record in place.
+func convert(record []string, columns []Column, line int) *RowError {
+	for _, col := range columns {
+		if col.Index >= len(record) {
+			return &RowError{Line: line, Field: col.Index + 1, Err: ErrMissingField}
+		}
+		value := record[col.Index]
+		if value == "" {
+			continue
+		}
+		converted, err := col.convert(value)
+		if err != nil {
+			return &RowError{Line: line, Field: col.Index + 1, Value: value, Err: err}
+		}
+		record[col.Index] = converted
+	}
+	return nil
+}
+
+// convert converts a single value of the column.
+func (col *Column) convert(value string) (string, error) {
+	loc := col.Location
+	if loc == nil {
+		loc = time.UTC
+	}
+	l := col.Locale
+	if l == nil {
+		l = jalali.LocalePersian
+	}
+
+	if col.Direction == ToJalali {
+		t, err := time.ParseInLocation(col.InputLayout, value, loc)
+		if err != nil {
+			return "", err
+		}
+		if t.Before(minTime) {
+			return "", ErrOutOfRange
+		}
+		return jalali.ToJalali(t).FormatLocale(col.OutputLayout, l), nil
+	}
+	j, err := jalali.ParseInLocale(col.InputLayout, value, loc, l)
+	if err != nil {
+		return "", err
+	}
+	if j.Year() < minYear {
+		return "", ErrOutOfRange
+	}
+	return j.ToTime().Format(col.OutputLayout), nil
+}
+
+// resolve returns the columns with the indexes of named columns looked up in
+// header.
+func (c *Converter) resolve(header []string) ([]Column, error) {
+	columns := make([]Column, len(c.Columns))
+	copy(columns, c.Columns)
+	for i := range columns {
+		if columns[i].Name == "" {
+			continue
+		}
+		found := false
+		for j, name := range header {
+			if name == columns[i].Name {
+				columns[i].Index, found = j, true
+				break
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("csvconv: no column %q in header", columns[i].Name)
+		}
+	}
+	return columns, c.validateColumns(columns)
+}
+
+// validate checks the columns of a converter without a header.
+func (c *Converter) validate() error {
+	for _, col := range c.Columns {
+		if col.Name != "" {
+			return fmt.Errorf("csvconv: column %q named without a header", col.Name)
+		}
+	}
+	return c.validateColumns(c.Columns)
+}
+
+// validateColumns checks the indexes, directions and layouts of columns.
+func (c *Converter) validateColumns(columns []Column) error {
+	for _, col := range columns {
+		switch {
+		case col.Index < 0:
+			return fmt.Errorf("csvconv: negative column index %d", col.Index)
+		case col.Direction != ToJalali && col.Direction != ToGregorian:
+			return fmt.Errorf("csvconv: column %d has no direction", col.Index)
+		case col.InputLayout == "" || col.OutputLayout == "":
+			return fmt.Errorf("csvconv: column %d has no layout", col.Index)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,147 @@
+/*
+MIT License
+
+Copyright (c) 2023 Mohammad Shafiee <muhammad.shafiee@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+
+package csvconv
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/mshafiee/jalali"
+)
+
+func TestConvert(t *testing.T) {
+	input := `id,created,due,note
+1,2023-08-11,1402/06/01,first
+2,2024-03-20 10:30,1403/01/01,"multi
+line"
+3,,1402/12/29,empty
+`
+	c := Converter{
+		Header: true,
+		Columns: []Column{
+			{Name: "created", Direction: ToJalali, InputLayout: "2006-01-02", OutputLayout: "%Y/%m/%d"},
+			{Index: 2, Direction: ToGregorian, InputLayout: "%Y/%m/%d", OutputLayout: "2006-01-02"},
+		},
+	}
+	var out, rejects strings.Builder
+	c.Rejects = &rejects
+
+	stats, err := c.Convert(&out, strings.NewReader(input))
+	if err != nil {
+		t.Fatalf("Convert() error = %v", err)
+	}
+	want := `id,created,due,note
+1,1402/05/20,2023-08-23,first
+3,,2024-03-19,empty
+`
+	if out.String() != want {
+		t.Errorf("Convert() wrote\n%s\nwant\n%s", out.String(), want)
+	}
+	if stats != (Stats{Records: 2, Rejected: 1}) {
+		t.Errorf("Convert() = %+v", stats)
+	}
+
+	wantRejects := `line,error,id,created,due,note
+3,"line 3, field 2: cannot convert ""2024-03-20 10:30"": parsing time ""2024-03-20 10:30"": extra text: "" 10:30""",2,2024-03-20 10:30,1403/01/01,"multi
+line"
+`
+	if rejects.String() != wantRejects {
+		t.Errorf("Convert() rejected\n%s\nwant\n%s", rejects.String(), wantRejects)
+	}
+}
+
+func TestConvertWithoutHeader(t *testing.T) {
+	tehran := time.FixedZone("IRST", 12600)
+	c := Converter{
+		Comma: ';',
+		Columns: []Column{
+			{Index: 0, Direction: ToJalali, InputLayout: time.RFC3339, OutputLayout: jalali.RFC3339, Location: tehran},
+			{Index: 1, Direction: ToGregorian, InputLayout: "%d %B %Y", OutputLayout: "Jan 2, 2006", Locale: jalali.LocaleEnglish},
+		},
+	}
+	var out strings.Builder
+	stats, err := c.Convert(&out, strings.NewReader("2023-08-11T13:00:00Z;20 Mordad 1402\n"))
+	if err != nil || stats.Records != 1 {
+		t.Fatalf("Convert() = %+v, %v", stats, err)
+	}
+	if want := "1402-05-20T13:00:00+00:00;Aug 11, 2023\n"; out.String() != want {
+		t.Errorf("Convert() wrote %q, want %q", out.String(), want)
+	}
+}
+
+func TestConvertErrors(t *testing.T) {
+	c := Converter{Columns: []Column{{Index: 1, Direction: ToJalali, InputLayout: "2006-01-02", OutputLayout: "%Y/%m/%d"}}}
+
+	// Without Rejects, the first bad record stops the conversion after the
+	// records before it are written.
+	var out strings.Builder
+	stats, err := c.Convert(&out, strings.NewReader("a,2023-08-11\nb\nc,2023-08-12\n"))
+	var rerr *RowError
+	if !errors.As(err, &rerr) || rerr.Line != 2 || rerr.Field != 2 || !errors.Is(err, ErrMissingField) {
+		t.Errorf("Convert() error = %v, want a missing field on line 2", err)
+	}
+	if stats.Records != 1 || out.String() != "a,1402/05/20\n" {
+		t.Errorf("Convert() = %+v, wrote %q", stats, out.String())
+	}
+
+	if _, err := c.Convert(&out, strings.NewReader("a,1500-01-01\n")); !errors.Is(err, ErrOutOfRange) {
+		t.Errorf("Convert() error = %v, want ErrOutOfRange", err)
+	}
+
+	// Jalali dates before 979 go to the rejects in the other direction too.
+	var rejects strings.Builder
+	back := Converter{
+		Columns: []Column{{Index: 1, Direction: ToGregorian, InputLayout: "%Y/%m/%d", OutputLayout: "2006-01-02"}},
+		Rejects: &rejects,
+	}
+	out.Reset()
+	stats, err = back.Convert(&out, strings.NewReader("a,0500/01/01\nb,0979/01/01\n"))
+	if err != nil || stats != (Stats{Records: 1, Rejected: 1}) || out.String() != "b,1600-03-20\n" {
+		t.Errorf("Convert() = %+v, %v, wrote %q", stats, err, out.String())
+	}
+	if !strings.Contains(rejects.String(), ErrOutOfRange.Error()) {
+		t.Errorf("Convert() rejected %q, want ErrOutOfRange", rejects.String())
+	}
+
+	for _, bad := range []Converter{
+		{Columns: []Column{{Index: -1, Direction: ToJalali, InputLayout: "2006", OutputLayout: "%Y"}}},
+		{Columns: []Column{{Index: 0, InputLayout: "2006", OutputLayout: "%Y"}}},
+		{Columns: []Column{{Index: 0, Direction: ToJalali}}},
+		{Columns: []Column{{Name: "date", Direction: ToJalali, InputLayout: "2006", OutputLayout: "%Y"}}},
+		{Header: true, Columns: []Column{{Name: "date", Direction: ToJalali, InputLayout: "2006", OutputLayout: "%Y"}}},
+	} {
+		if _, err := bad.Convert(&out, strings.NewReader("created\n2023\n")); err == nil {
+			t.Errorf("Convert() accepted columns %+v", bad.Columns)
+		}
+	}
+
+	// Errors in the CSV syntax are not rejects.
+	c.Rejects = &strings.Builder{}
+	if _, err := c.Convert(&out, strings.NewReader("a,\"2023\n")); err == nil {
+		t.Error("Convert() accepted a bare quote")
+	}
+}
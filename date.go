@@ -0,0 +1,260 @@
+/*
+MIT License
+
+Copyright (c) 2023 Mohammad Shafiee <muhammad.shafiee@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+
+package jalali
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// JalaliDate is a day of the Jalali calendar, without a time of day or a
+// location. It is suited to birthdays, holidays and due dates, which do not
+// change with the time zone. JalaliDate values can be compared with ==.
+//
+// The zero JalaliDate, like a date such as {1402, 13, 40}, is not a valid day
+// of the calendar (see IsValid). The methods that compute with a date return
+// a zero result for one, as documented on each.
+type JalaliDate struct {
+	Year  int
+	Month Month
+	Day   int
+}
+
+// Date returns the day of j in its location.
+func (j JalaliTime) Date() JalaliDate {
+	return JalaliDate{j.year, j.month, j.day}
+}
+
+// DateOf returns the Jalali day of t in its location.
+func DateOf(t time.Time) JalaliDate {
+	year, month, day := gregorianToJalali(t.Date())
+	return JalaliDate{year, month, day}
+}
+
+// Today returns the current day in the local time zone.
+func Today() JalaliDate {
+	return DateOf(time.Now())
+}
+
+// ParseDate parses a date formatted according to layout. Time of day and zone
+// fields in the layout are read but ignored. See ParseInLocation.
+func ParseDate(layout, value string) (JalaliDate, error) {
+	j, err := ParseInLocation(layout, value, time.UTC)
+	if err != nil {
+		return JalaliDate{}, err
+	}
+	return j.Date(), nil
+}
+
+// IsValid reports whether d is a day of the Jalali calendar.
+func (d JalaliDate) IsValid() bool {
+	return isValidJalaliDate(d.Year, int(d.Month), d.Day)
+}
+
+// IsZero reports whether d is the zero JalaliDate.
+func (d JalaliDate) IsZero() bool {
+	return d == JalaliDate{}
+}
+
+// In returns the start of the day d in the given location, or the zero
+// JalaliTime if d is not valid.
+func (d JalaliDate) In(loc *time.Location) JalaliTime {
+	if !d.IsValid() {
+		return JalaliTime{}
+	}
+	return d.at(loc)
+}
+
+// Weekday returns the day of the week of d, or -1 if d is not valid.
+func (d JalaliDate) Weekday() Weekday {
+	if !d.IsValid() {
+		return -1
+	}
+	return Weekday((d.days()%7 + 11) % 7)
+}
+
+// AddDays returns the date n days after d, or the zero JalaliDate if d is not valid.
+func (d JalaliDate) AddDays(n int) JalaliDate {
+	if !d.IsValid() {
+		return JalaliDate{}
+	}
+	gYear, gMonth, gDay := jalaliToGregorian(d.Year, d.Month, d.Day)
+	return DateOf(time.Date(gYear, gMonth, gDay+n, 0, 0, 0, 0, time.UTC))
+}
+
+// AddMonths returns the date n months after d. If the day does not exist in
+// the resulting month, the last day of that month is used. It returns the
+// zero JalaliDate if d is not valid.
+func (d JalaliDate) AddMonths(n int) JalaliDate {
+	if !d.IsValid() {
+		return JalaliDate{}
+	}
+	year, month := shiftMonth(d.Year, d.Month, n)
+	if days := daysInMonth(year, month); d.Day > days {
+		return JalaliDate{year, month, days}
+	}
+	return JalaliDate{year, month, d.Day}
+}
+
+// AddYears returns the date n years after d. Esfand 30 becomes Esfand 29 in a
+// common year. It returns the zero JalaliDate if d is not valid.
+func (d JalaliDate) AddYears(n int) JalaliDate {
+	return d.AddMonths(12 * n)
+}
+
+// DaysUntil returns the number of days from d to u, which is negative if u is
+// before d. It returns 0 if d or u is not valid.
+func (d JalaliDate) DaysUntil(u JalaliDate) int {
+	if !d.IsValid() || !u.IsValid() {
+		return 0
+	}
+	return u.days() - d.days()
+}
+
+// Compare returns -1 if d is before u, 0 if they are the same day and +1 if d is after u.
+func (d JalaliDate) Compare(u JalaliDate) int {
+	switch {
+	case d.Year != u.Year:
+		return compareInt(d.Year, u.Year)
+	case d.Month != u.Month:
+		return compareInt(int(d.Month), int(u.Month))
+	}
+	return compareInt(d.Day, u.Day)
+}
+
+// Before reports whether d is before u.
+func (d JalaliDate) Before(u JalaliDate) bool {
+	return d.Compare(u) < 0
+}
+
+// After reports whether d is after u.
+func (d JalaliDate) After(u JalaliDate) bool {
+	return d.Compare(u) > 0
+}
+
+// Format returns d formatted according to layout. Time of day specifiers
+// write midnight, and zone specifiers write UTC. See JalaliTime.Format.
+func (d JalaliDate) Format(layout string) string {
+	return d.at(time.UTC).Format(layout)
+}
+
+// FormatLocale is like Format, but uses the names and era of the given locale.
+func (d JalaliDate) FormatLocale(layout string, l *Locale) string {
+	return d.at(time.UTC).FormatLocale(layout, l)
+}
+
+// String returns d in the form "1402/05/20".
+func (d JalaliDate) String() string {
+	return d.Format("%Y/%m/%d")
+}
+
+// MarshalText implements the encoding.TextMarshaler interface. The date is
+// written in the DateOnly layout; the zero JalaliDate is empty.
+func (d JalaliDate) MarshalText() ([]byte, error) {
+	if d.IsZero() {
+		return []byte{}, nil
+	}
+	return d.at(time.UTC).AppendFormat(make([]byte, 0, len("1402-05-20")), DateOnly), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+func (d *JalaliDate) UnmarshalText(data []byte) error {
+	if len(data) == 0 {
+		*d = JalaliDate{}
+		return nil
+	}
+	parsed, err := ParseDate(DateOnly, string(data))
+	if err != nil {
+		return err
+	}
+	*d = parsed
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaler interface. The date is a quoted
+// string in the DateOnly layout; the zero JalaliDate is null.
+func (d JalaliDate) MarshalJSON() ([]byte, error) {
+	if d.IsZero() {
+		return []byte("null"), nil
+	}
+	return json.Marshal(d.Format(DateOnly))
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface. As with time.Time,
+// null is a no-op.
+func (d *JalaliDate) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return fmt.Errorf("JalaliDate: expected a JSON string, got %s", data)
+	}
+	return d.UnmarshalText([]byte(s))
+}
+
+// Scan implements the sql.Scanner interface for DATE columns. It accepts the
+// values JalaliTime.Scan accepts and keeps their day.
+func (d *JalaliDate) Scan(src any) error {
+	var j JalaliTime
+	if err := j.Scan(src); err != nil {
+		return err
+	}
+	*d = j.Date()
+	return nil
+}
+
+// Value implements the driver.Valuer interface. It returns midnight UTC of the
+// Gregorian day, the form drivers expect for DATE columns, or NULL for the
+// zero JalaliDate.
+func (d JalaliDate) Value() (driver.Value, error) {
+	if d.IsZero() {
+		return nil, nil
+	}
+	return d.at(time.UTC).ToTime(), nil
+}
+
+// at returns the start of the day d in loc, without checking that d is valid.
+func (d JalaliDate) at(loc *time.Location) JalaliTime {
+	return JalaliTime{year: d.Year, month: d.Month, day: d.Day, loc: loc}
+}
+
+// days returns the number of days from 1970-01-01 to d.
+func (d JalaliDate) days() int {
+	return civilDay(d.at(time.UTC))
+}
+
+// compareInt returns -1, 0 or +1 as a is less than, equal to or greater than b.
+func compareInt(a, b int) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,194 @@
+/*
+MIT License
+
+Copyright (c) 2023 Mohammad Shafiee <muhammad.shafiee@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+
+package jalali
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestJalaliDateConversions(t *testing.T) {
+	tehran := time.FixedZone("IRST", 12600)
+	j := Date(1402, Mordad, 20, 1, 30, 0, 0, tehran)
+	d := j.Date()
+	if d != (JalaliDate{1402, Mordad, 20}) {
+		t.Errorf("Date() = %v", d)
+	}
+	if got := d.In(tehran); got != Date(1402, Mordad, 20, 0, 0, 0, 0, tehran) {
+		t.Errorf("In() = %v", got)
+	}
+	// The same instant falls on the previous day in UTC.
+	if got := DateOf(j.ToTime().UTC()); got != (JalaliDate{1402, Mordad, 19}) {
+		t.Errorf("DateOf() = %v", got)
+	}
+	if Today() != Now().Date() {
+		t.Errorf("Today() = %v, want %v", Today(), Now().Date())
+	}
+	if !d.IsValid() || (JalaliDate{1402, Esfand, 30}).IsValid() || !(JalaliDate{}).IsZero() {
+		t.Error("IsValid() or IsZero() is wrong")
+	}
+}
+
+func TestJalaliDateArithmetic(t *testing.T) {
+	d := JalaliDate{1402, Mordad, 31}
+
+	tests := []struct {
+		name      string
+		got, want JalaliDate
+	}{
+		{"AddDays(1)", d.AddDays(1), JalaliDate{1402, Shahrivar, 1}},
+		{"AddDays(-31)", d.AddDays(-31), JalaliDate{1402, Tir, 31}},
+		{"AddDays(365)", d.AddDays(365), JalaliDate{1403, Mordad, 31}},
+		{"AddMonths(2)", d.AddMonths(2), JalaliDate{1402, Mehr, 30}},
+		{"AddMonths(-5)", d.AddMonths(-5), JalaliDate{1401, Esfand, 29}},
+		{"AddMonths(-6)", d.AddMonths(-6), JalaliDate{1401, Bahman, 30}},
+		{"AddYears(1)", (JalaliDate{1403, Esfand, 30}).AddYears(1), JalaliDate{1404, Esfand, 29}},
+		{"AddYears(-4)", (JalaliDate{1403, Esfand, 30}).AddYears(-4), JalaliDate{1399, Esfand, 30}},
+	}
+	for _, tc := range tests {
+		if tc.got != tc.want {
+			t.Errorf("%s = %v, want %v", tc.name, tc.got, tc.want)
+		}
+	}
+
+	nowruz := JalaliDate{1403, Farvardin, 1}
+	if n := d.DaysUntil(nowruz); n != 211 {
+		t.Errorf("DaysUntil() = %d, want 211", n)
+	}
+	if n := nowruz.DaysUntil(d); n != -211 {
+		t.Errorf("DaysUntil() = %d, want -211", n)
+	}
+	if !d.Before(nowruz) || d.After(nowruz) || nowruz.Compare(d) != 1 || d.Compare(d) != 0 {
+		t.Error("comparison is wrong")
+	}
+}
+
+func TestJalaliDateWeekday(t *testing.T) {
+	for d := (JalaliDate{1402, Farvardin, 1}); d.Year == 1402; d = d.AddDays(1) {
+		if got, want := d.Weekday(), d.In(time.UTC).Weekday(); got != want {
+			t.Fatalf("Weekday(%v) = %v, want %v", d, got, want)
+		}
+	}
+	if got := (JalaliDate{1348, Dey, 11}).Weekday(); got != Panjshanbe {
+		t.Errorf("Weekday(1348/10/11) = %v, want Panjshanbe", got)
+	}
+}
+
+func TestJalaliDateInvalid(t *testing.T) {
+	valid := JalaliDate{1402, Mordad, 20}
+	for _, d := range []JalaliDate{{}, {1402, 13, 40}, {1402, Esfand, 30}, {0, Farvardin, 1}} {
+		if got := d.In(time.UTC); !got.IsZero() {
+			t.Errorf("%v.In() = %v, want the zero JalaliTime", d, got)
+		}
+		if got := d.Weekday(); got != -1 {
+			t.Errorf("%v.Weekday() = %v, want -1", d, got)
+		}
+		if got := d.AddDays(1); got != (JalaliDate{}) {
+			t.Errorf("%v.AddDays(1) = %v, want the zero JalaliDate", d, got)
+		}
+		if got := d.AddMonths(1); got != (JalaliDate{}) {
+			t.Errorf("%v.AddMonths(1) = %v, want the zero JalaliDate", d, got)
+		}
+		if got := d.AddYears(1); got != (JalaliDate{}) {
+			t.Errorf("%v.AddYears(1) = %v, want the zero JalaliDate", d, got)
+		}
+		if n, m := d.DaysUntil(valid), valid.DaysUntil(d); n != 0 || m != 0 {
+			t.Errorf("DaysUntil() with %v = %d, %d, want 0", d, n, m)
+		}
+	}
+	if got := (JalaliDate{}).String(); got != "0000/00/00" {
+		t.Errorf("String() = %q, want %q", got, "0000/00/00")
+	}
+}
+
+func TestJalaliDateFormatParse(t *testing.T) {
+	d := JalaliDate{1402, Mordad, 20}
+	if got := d.String(); got != "1402/05/20" {
+		t.Errorf("String() = %q", got)
+	}
+	if got := d.Format("%w %d %B %Y"); got != "جمعه 20 مرداد 1402" {
+		t.Errorf("Format() = %q", got)
+	}
+	if got := d.FormatLocale("%d %B %Y", LocaleEnglish); got != "20 Mordad 1402" {
+		t.Errorf("FormatLocale() = %q", got)
+	}
+
+	got, err := ParseDate("%Y/%m/%d %H:%M %z", "1402/05/20 23:30 -0800")
+	if err != nil || got != d {
+		t.Errorf("ParseDate() = %v, %v, want %v", got, err, d)
+	}
+	if _, err := ParseDate("%Y/%m/%d", "1402/12/30"); err == nil {
+		t.Error("ParseDate() of an invalid date error = nil, want an error")
+	}
+}
+
+func TestJalaliDateEncoding(t *testing.T) {
+	type person struct {
+		Birthday JalaliDate            `json:"birthday"`
+		Holidays map[JalaliDate]string `json:"holidays"`
+		Due      JalaliDate            `json:"due"`
+	}
+	p := person{
+		Birthday: JalaliDate{1370, Mehr, 1},
+		Holidays: map[JalaliDate]string{{1403, Farvardin, 1}: "Nowruz"},
+	}
+
+	data, err := json.Marshal(p)
+	want := `{"birthday":"1370-07-01","holidays":{"1403-01-01":"Nowruz"},"due":null}`
+	if err != nil || string(data) != want {
+		t.Errorf("Marshal() = %s, %v, want %s", data, err, want)
+	}
+
+	var got person
+	if err := json.Unmarshal(data, &got); err != nil || got.Birthday != p.Birthday || got.Holidays[JalaliDate{1403, Farvardin, 1}] != "Nowruz" || !got.Due.IsZero() {
+		t.Errorf("Unmarshal() = %+v, %v", got, err)
+	}
+	if err := json.Unmarshal([]byte(`{"birthday":"1370/07/01"}`), &got); err == nil {
+		t.Error("Unmarshal() of another layout error = nil, want an error")
+	}
+}
+
+func TestJalaliDateSQL(t *testing.T) {
+	d := JalaliDate{1402, Mordad, 20}
+	v, err := d.Value()
+	if err != nil || v != time.Date(2023, time.August, 11, 0, 0, 0, 0, time.UTC) {
+		t.Errorf("Value() = %v, %v", v, err)
+	}
+	if v, err := (JalaliDate{}).Value(); v != nil || err != nil {
+		t.Errorf("Value() of the zero JalaliDate = %v, %v, want nil", v, err)
+	}
+
+	for _, src := range []any{v, "1402/05/20", []byte("2023-08-11")} {
+		var got JalaliDate
+		if err := got.Scan(src); err != nil || got != d {
+			t.Errorf("Scan(%#v) = %v, %v, want %v", src, got, err, d)
+		}
+	}
+	var got JalaliDate
+	if err := got.Scan(nil); err == nil {
+		t.Error("Scan(nil) error = nil, want an error")
+	}
+}
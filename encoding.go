@@ -0,0 +1,158 @@
+/*
+MIT License
+
+Copyright (c) 2023 Mohammad Shafiee <muhammad.shafiee@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+
+package jalali
+
+import (
+	"encoding/binary"
+	"errors"
+	"time"
+)
+
+// MarshalText implements the encoding.TextMarshaler interface. The time is
+// formatted with RFC3339, or with RFC3339Nano if it has fractional seconds; the
+// zero JalaliTime is empty.
+func (j JalaliTime) MarshalText() ([]byte, error) {
+	if j.IsZero() {
+		return []byte{}, nil
+	}
+	layout := RFC3339
+	if j.nsec != 0 {
+		layout = RFC3339Nano
+	}
+	return j.AppendFormat(make([]byte, 0, 40), layout), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface. The time
+// must be in the RFC 3339 layouts written by MarshalText.
+func (j *JalaliTime) UnmarshalText(data []byte) error {
+	if len(data) == 0 {
+		*j = JalaliTime{}
+		return nil
+	}
+	t, err := Parse(RFC3339, string(data))
+	if err != nil {
+		return err
+	}
+	*j = t
+	return nil
+}
+
+// binaryVersion is the version of the encoding written by MarshalBinary.
+const binaryVersion byte = 1
+
+// binaryHeaderLen is the length of the binary encoding without the location name.
+const binaryHeaderLen = 18
+
+// MarshalBinary implements the encoding.BinaryMarshaler interface. The
+// encoding holds a version byte, the date and clock fields, the zone offset in
+// effect and the name of the location, so that UnmarshalBinary restores the
+// same location when it is known on the decoding side.
+func (j JalaliTime) MarshalBinary() ([]byte, error) {
+	if j.year < 0 || j.year > 0xffff {
+		return nil, errors.New("JalaliTime.MarshalBinary: year out of range")
+	}
+
+	var name string
+	var hasLoc byte
+	offset := 0
+	if j.loc != nil {
+		name, hasLoc = j.loc.String(), 1
+		_, offset = j.Zone()
+	}
+	if len(name) > 0xff {
+		return nil, errors.New("JalaliTime.MarshalBinary: location name too long")
+	}
+
+	b := make([]byte, binaryHeaderLen, binaryHeaderLen+len(name))
+	b[0] = binaryVersion
+	binary.BigEndian.PutUint16(b[1:], uint16(j.year))
+	b[3] = byte(j.month)
+	b[4] = byte(j.day)
+	b[5] = byte(j.hour)
+	b[6] = byte(j.min)
+	b[7] = byte(j.sec)
+	binary.BigEndian.PutUint32(b[8:], uint32(j.nsec))
+	binary.BigEndian.PutUint32(b[12:], uint32(int32(offset)))
+	b[16] = hasLoc
+	b[17] = byte(len(name))
+	return append(b, name...), nil
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface. The
+// location is the named one if it is known and has the encoded offset at that
+// time, and otherwise a fixed zone with the encoded name and offset.
+func (j *JalaliTime) UnmarshalBinary(data []byte) error {
+	if len(data) == 0 {
+		return errors.New("JalaliTime.UnmarshalBinary: no data")
+	}
+	if data[0] != binaryVersion {
+		return errors.New("JalaliTime.UnmarshalBinary: unsupported version")
+	}
+	if len(data) < binaryHeaderLen || len(data) != binaryHeaderLen+int(data[17]) {
+		return errors.New("JalaliTime.UnmarshalBinary: invalid length")
+	}
+
+	t := JalaliTime{
+		year:  int(binary.BigEndian.Uint16(data[1:])),
+		month: Month(data[3]),
+		day:   int(data[4]),
+		hour:  int(data[5]),
+		min:   int(data[6]),
+		sec:   int(data[7]),
+		nsec:  int(binary.BigEndian.Uint32(data[8:])),
+	}
+	if !t.IsZero() && (!isValidJalaliDate(t.year, int(t.month), t.day) ||
+		t.hour > 23 || t.min > 59 || t.sec > 59 || t.nsec > 999999999) {
+		return errors.New("JalaliTime.UnmarshalBinary: invalid time")
+	}
+
+	if data[16] != 0 {
+		offset := int(int32(binary.BigEndian.Uint32(data[12:])))
+		name := string(data[binaryHeaderLen:])
+		t.loc = lookupZone(name)
+		if t.loc == nil || zoneOffset(t) != offset {
+			t.loc = time.FixedZone(name, offset)
+		}
+	}
+
+	*j = t
+	return nil
+}
+
+// zoneOffset returns the zone offset of the wall clock time of t in its location.
+func zoneOffset(t JalaliTime) int {
+	_, offset := t.Zone()
+	return offset
+}
+
+// GobEncode implements the gob.GobEncoder interface.
+func (j JalaliTime) GobEncode() ([]byte, error) {
+	return j.MarshalBinary()
+}
+
+// GobDecode implements the gob.GobDecoder interface.
+func (j *JalaliTime) GobDecode(data []byte) error {
+	return j.UnmarshalBinary(data)
+}
@@ -0,0 +1,163 @@
+/*
+MIT License
+
+Copyright (c) 2023 Mohammad Shafiee <muhammad.shafiee@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+
+package jalali
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"encoding/xml"
+	"testing"
+	"time"
+)
+
+func TestJalaliTimeText(t *testing.T) {
+	tehran := time.FixedZone("IRST", 12600)
+	tests := []struct {
+		j    JalaliTime
+		text string
+	}{
+		{Date(1402, Mordad, 20, 16, 30, 45, 0, tehran), "1402-05-20T16:30:45+03:30"},
+		{Date(1402, Mordad, 20, 16, 30, 45, 500, time.UTC), "1402-05-20T16:30:45.000000500+00:00"},
+		{JalaliTime{}, ""},
+	}
+
+	for _, tc := range tests {
+		text, err := tc.j.MarshalText()
+		if err != nil || string(text) != tc.text {
+			t.Errorf("MarshalText(%v) = %q, %v, want %q", tc.j, text, err, tc.text)
+		}
+		var got JalaliTime
+		if err := got.UnmarshalText(text); err != nil || !sameInstant(got, tc.j) && !(got.IsZero() && tc.j.IsZero()) {
+			t.Errorf("UnmarshalText(%q) = %v, %v, want %v", text, got, err, tc.j)
+		}
+	}
+
+	var got JalaliTime
+	if err := got.UnmarshalText([]byte("1402/05/20")); err == nil {
+		t.Error("UnmarshalText() of another layout error = nil, want an error")
+	}
+}
+
+func TestJalaliTimeTextKeys(t *testing.T) {
+	j := Date(1402, Mordad, 20, 0, 0, 0, 0, time.UTC)
+
+	data, err := json.Marshal(map[JalaliTime]int{j: 1})
+	if err != nil || string(data) != `{"1402-05-20T00:00:00+00:00":1}` {
+		t.Errorf("Marshal() of a map = %s, %v", data, err)
+	}
+	var m map[JalaliTime]int
+	if err := json.Unmarshal(data, &m); err != nil || len(m) != 1 {
+		t.Errorf("Unmarshal() of a map = %v, %v", m, err)
+	}
+
+	type event struct {
+		At JalaliTime `xml:"at,attr"`
+	}
+	data, err = xml.Marshal(event{j})
+	if err != nil || string(data) != `<event at="1402-05-20T00:00:00+00:00"></event>` {
+		t.Errorf("xml.Marshal() = %s, %v", data, err)
+	}
+	var e event
+	if err := xml.Unmarshal(data, &e); err != nil || !sameInstant(e.At, j) {
+		t.Errorf("xml.Unmarshal() = %v, %v", e.At, err)
+	}
+}
+
+func TestJalaliTimeBinary(t *testing.T) {
+	tests := []JalaliTime{
+		Date(1402, Mordad, 20, 16, 30, 45, 123456789, Tehran()),
+		Date(1402, Mordad, 20, 16, 30, 45, 0, time.UTC),
+		Date(1, Farvardin, 1, 0, 0, 0, 0, time.FixedZone("", -5400)),
+		Date(9999, Esfand, 29, 23, 59, 59, 999999999, time.FixedZone("XYZ", 3600)),
+		{},
+	}
+
+	for _, j := range tests {
+		data, err := j.MarshalBinary()
+		if err != nil {
+			t.Errorf("MarshalBinary(%v) error = %v", j, err)
+			continue
+		}
+		var got JalaliTime
+		if err := got.UnmarshalBinary(data); err != nil {
+			t.Errorf("UnmarshalBinary(%v) error = %v", j, err)
+			continue
+		}
+		if got != j && !(got.Equal(j) && zoneOffset(got) == zoneOffset(j)) {
+			t.Errorf("UnmarshalBinary(MarshalBinary(%v)) = %v in %v, want %v", j, got, got.Location(), j.Location())
+		}
+	}
+
+	// An unknown location name is restored as a fixed zone with the same offset.
+	j := Date(1402, Mordad, 20, 16, 30, 45, 0, time.FixedZone("Mars/Olympus", 7200))
+	data, _ := j.MarshalBinary()
+	var got JalaliTime
+	if err := got.UnmarshalBinary(data); err != nil || got.Location().String() != "Mars/Olympus" || zoneOffset(got) != 7200 {
+		t.Errorf("UnmarshalBinary() of an unknown zone = %v, %v", got, err)
+	}
+}
+
+func TestJalaliTimeBinaryErrors(t *testing.T) {
+	valid, _ := Date(1402, Mordad, 20, 0, 0, 0, 0, time.UTC).MarshalBinary()
+
+	invalidVersion := append([]byte{}, valid...)
+	invalidVersion[0] = 2
+	invalidDate := append([]byte{}, valid...)
+	invalidDate[4] = 32
+
+	for name, data := range map[string][]byte{
+		"empty":     nil,
+		"version":   invalidVersion,
+		"truncated": valid[:10],
+		"long":      append(append([]byte{}, valid...), 'x'),
+		"date":      invalidDate,
+	} {
+		var got JalaliTime
+		if err := got.UnmarshalBinary(data); err == nil {
+			t.Errorf("UnmarshalBinary() of %s data error = nil, want an error", name)
+		}
+	}
+}
+
+func TestJalaliTimeGob(t *testing.T) {
+	type entry struct {
+		Key     string
+		Expires JalaliTime
+	}
+	want := entry{"session", Date(1402, Mordad, 20, 16, 30, 45, 0, Tehran())}
+
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(want); err != nil {
+		t.Fatalf("Encode() error = %v", err)
+	}
+	var got entry
+	if err := gob.NewDecoder(&buf).Decode(&got); err != nil {
+		t.Fatalf("Decode() error = %v", err)
+	}
+	if got.Key != want.Key || !got.Expires.Equal(want.Expires) {
+		t.Errorf("Decode() = %+v, want %+v", got, want)
+	}
+}
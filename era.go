@@ -0,0 +1,183 @@
+/*
+MIT License
+
+Copyright (c) 2023 Mohammad Shafiee <muhammad.shafiee@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+
+package jalali
+
+import "time"
+
+// Era describes a year numbering that differs from the Jalali year by a fixed offset.
+// The zero value is the Jalali era itself.
+type Era struct {
+	// Name is the English name of the era.
+	Name string
+
+	// FaName is the Persian name of the era.
+	FaName string
+
+	// Offset is added to the Jalali year to obtain the year in the era.
+	Offset int
+}
+
+// EraKurdish is the Kurdish year numbering, which is the Jalali year plus 1321.
+var EraKurdish = Era{Name: "Kurdish", FaName: "کردی", Offset: 1321}
+
+// EraShahanshahi is the Imperial year numbering used in Iran from 1976 to 1978,
+// which is the Jalali year plus 1180 (1355 is 2535).
+var EraShahanshahi = Era{Name: "Shahanshahi", FaName: "شاهنشاهی", Offset: 1180}
+
+// EraZoroastrian is the year numbering used by Iranian Zoroastrians with the
+// Fasli calendar, which is the Jalali year plus 2359.
+var EraZoroastrian = Era{Name: "Zoroastrian", FaName: "زرتشتی", Offset: 2359}
+
+// EnZoroastrianDayName contains the names of the 30 days of a Zoroastrian month in English.
+var EnZoroastrianDayName = []string{
+	"",
+	"Hormozd", "Bahman", "Ordibehesht", "Shahrivar", "Sepandarmaz",
+	"Khordad", "Amordad", "Dey-be-Azar", "Azar", "Aban",
+	"Khorshid", "Mah", "Tir", "Goosh", "Dey-be-Mehr",
+	"Mehr", "Soroush", "Rashn", "Farvardin", "Bahram",
+	"Ram", "Bad", "Dey-be-Din", "Din", "Ard",
+	"Ashtad", "Asman", "Zamyad", "Mahraspand", "Anaram",
+}
+
+// FaZoroastrianDayName contains the names of the 30 days of a Zoroastrian month in Persian.
+var FaZoroastrianDayName = []string{
+	"",
+	"هرمزد", "بهمن", "اردیبهشت", "شهریور", "سپندارمذ",
+	"خرداد", "امرداد", "دی‌به‌آذر", "آذر", "آبان",
+	"خور", "ماه", "تیر", "گوش", "دی‌به‌مهر",
+	"مهر", "سروش", "رشن", "فروردین", "ورهرام",
+	"رام", "باد", "دی‌به‌دین", "دین", "ارد",
+	"اشتاد", "آسمان", "زامیاد", "مانتره‌سپند", "انارام",
+}
+
+// EnGathaDayName contains the names of the five Gatha days at the end of the
+// Zoroastrian year in English, followed by the name of the leap day.
+var EnGathaDayName = []string{"Ahunavad", "Ushtavad", "Spentamad", "Vohukhshathra", "Vahishtoisht", "Avardad-sal-gah"}
+
+// FaGathaDayName contains the names of the five Gatha days at the end of the
+// Zoroastrian year in Persian, followed by the name of the leap day.
+var FaGathaDayName = []string{"اهنود", "اشتود", "سپنتمد", "وهوخشتر", "وهیشتوایشت", "اورداد"}
+
+// Year returns the year in the era that corresponds to the given Jalali year.
+func (e Era) Year(jalaliYear int) int {
+	return jalaliYear + e.Offset
+}
+
+// JalaliYear returns the Jalali year that corresponds to the given year in the era.
+func (e Era) JalaliYear(year int) int {
+	return year - e.Offset
+}
+
+// EraYear returns the year of the Jalali date in the given era.
+func (j JalaliTime) EraYear(e Era) int {
+	return e.Year(j.year)
+}
+
+// ZoroastrianReckoning is a way of reckoning the days of the Zoroastrian calendar.
+type ZoroastrianReckoning int
+
+const (
+	// ReckoningFasli is the reckoning used in Iran, whose year starts with the
+	// Jalali year and has a leap day when the Jalali year does.
+	ReckoningFasli ZoroastrianReckoning = iota
+
+	// ReckoningShenshai is the reckoning of most Parsis, whose 365-day years
+	// have no leap day, so that the new year moves through the seasons.
+	ReckoningShenshai
+
+	// ReckoningQadimi is the reckoning of 365-day years that runs 30 days
+	// ahead of ReckoningShenshai.
+	ReckoningQadimi
+)
+
+// shenshaiNewYear and qadimiNewYear are the days of a Shenshai and a Qadimi
+// new year, Hormozd of Farvardin 1393 Y.Z., in days since the Unix epoch:
+// August 16, 2023 and July 17, 2023.
+const (
+	shenshaiNewYear = 19585
+	qadimiNewYear   = shenshaiNewYear - 30
+)
+
+// ZoroastrianDayName returns the English name of the day in the Zoroastrian Fasli calendar.
+// The Fasli year starts with the Jalali year and has twelve months of 30 named days, followed
+// by the five Gatha days and, in leap years, one extra day. See ZoroastrianDayNameIn for
+// the other reckonings. It returns "" if j is not a valid date.
+func (j JalaliTime) ZoroastrianDayName() string {
+	return j.ZoroastrianDayNameIn(ReckoningFasli)
+}
+
+// ZoroastrianDayFaName returns the Persian name of the day in the Zoroastrian Fasli calendar.
+func (j JalaliTime) ZoroastrianDayFaName() string {
+	return j.ZoroastrianDayFaNameIn(ReckoningFasli)
+}
+
+// ZoroastrianDayNameIn returns the English name of the day in the Zoroastrian
+// calendar reckoned by r. It returns "" if j is not a valid date or r is not
+// a known reckoning.
+func (j JalaliTime) ZoroastrianDayNameIn(r ZoroastrianReckoning) string {
+	return zoroastrianDayName(j, r, EnZoroastrianDayName, EnGathaDayName)
+}
+
+// ZoroastrianDayFaNameIn returns the Persian name of the day in the
+// Zoroastrian calendar reckoned by r, as ZoroastrianDayNameIn does.
+func (j JalaliTime) ZoroastrianDayFaNameIn(r ZoroastrianReckoning) string {
+	return zoroastrianDayName(j, r, FaZoroastrianDayName, FaGathaDayName)
+}
+
+// zoroastrianDayName returns the name of the day of j in the reckoning r.
+func zoroastrianDayName(j JalaliTime, r ZoroastrianReckoning, dayNames, gathaNames []string) string {
+	if !isValidJalaliDate(j.year, int(j.month), j.day) {
+		return ""
+	}
+
+	var yd int
+	switch r {
+	case ReckoningFasli:
+		yd = yearDay(j.month, j.day)
+	case ReckoningShenshai, ReckoningQadimi:
+		newYear := int64(shenshaiNewYear)
+		if r == ReckoningQadimi {
+			newYear = qadimiNewYear
+		}
+		gYear, gMonth, gDay := jalaliToGregorian(j.year, j.month, j.day)
+		days := time.Date(gYear, gMonth, gDay, 0, 0, 0, 0, time.UTC).Unix() / 86400
+		yd = int(((days-newYear)%365+365)%365) + 1
+	default:
+		return ""
+	}
+
+	if yd <= 360 {
+		return dayNames[(yd-1)%30+1]
+	}
+	return gathaNames[yd-361]
+}
+
+// yearDay returns the day of the Jalali year (1-366) of the given month and day.
+func yearDay(month Month, day int) int {
+	if month <= Shahrivar {
+		return int(month-1)*31 + day
+	}
+	return 186 + int(month-Mehr)*30 + day
+}
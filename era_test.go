@@ -0,0 +1,165 @@
+/*
+MIT License
+
+Copyright (c) 2023 Mohammad Shafiee <muhammad.shafiee@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+
+package jalali
+
+import (
+	"testing"
+	"time"
+)
+
+func TestEraYear(t *testing.T) {
+	j := Date(1402, Mordad, 20, 0, 0, 0, 0, time.UTC)
+	if got := j.EraYear(EraKurdish); got != 2723 {
+		t.Errorf("EraYear(EraKurdish) = %d, want %d", got, 2723)
+	}
+	if got := j.EraYear(Era{}); got != 1402 {
+		t.Errorf("EraYear(Era{}) = %d, want %d", got, 1402)
+	}
+	if got := EraKurdish.JalaliYear(2723); got != 1402 {
+		t.Errorf("EraKurdish.JalaliYear(2723) = %d, want %d", got, 1402)
+	}
+}
+
+func TestKurdishLocale(t *testing.T) {
+	j := Date(1402, Farvardin, 1, 9, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		locale   *Locale
+		layout   string
+		expected string
+	}{
+		{LocaleKurmanji, "%d %B %Y", "01 Xakelêwe 2723"},
+		{LocaleSorani, "%d %B %Y", "01 خاکەلێوە 2723"},
+		{LocaleSorani.WithEra(Era{}), "%Y/%m/%d", "1402/01/01"},
+		{LocalePersian.WithEra(EraKurdish), "%Y %B %d", "2723 فروردین 01"},
+	}
+
+	for _, tc := range tests {
+		if got := j.FormatLocale(tc.layout, tc.locale); got != tc.expected {
+			t.Errorf("FormatLocale(%q, %s) = %v, want %v", tc.layout, tc.locale.Tag, got, tc.expected)
+		}
+
+		parsed, err := ParseInLocale(tc.layout+" %H", tc.expected+" 09", time.UTC, tc.locale)
+		if err != nil {
+			t.Errorf("ParseInLocale(%q, %q) error = %v", tc.layout, tc.expected, err)
+		} else if parsed != j {
+			t.Errorf("ParseInLocale(%q, %q) = %v, want %v", tc.layout, tc.expected, parsed, j)
+		}
+	}
+}
+
+func TestShahanshahiAndZoroastrianEras(t *testing.T) {
+	j := Date(1355, Farvardin, 1, 0, 0, 0, 0, time.UTC)
+
+	if got := j.FormatLocale("%Y", LocalePersian.WithEra(EraShahanshahi)); got != "2535" {
+		t.Errorf("Shahanshahi year = %v, want %v", got, "2535")
+	}
+	if got := j.EraYear(EraZoroastrian); got != 3714 {
+		t.Errorf("Zoroastrian year = %v, want %v", got, 3714)
+	}
+
+	parsed, err := ParseInLocale("%Y/%m/%d", "2537/11/22", time.UTC, LocalePersian.WithEra(EraShahanshahi))
+	if err != nil {
+		t.Fatalf("ParseInLocale() error = %v", err)
+	}
+	if parsed.Year() != 1357 || parsed.Month() != Bahman || parsed.Day() != 22 {
+		t.Errorf("ParseInLocale() = %v, want 1357/11/22", parsed)
+	}
+}
+
+func TestZoroastrianDayName(t *testing.T) {
+	testCases := []struct {
+		year   int
+		month  Month
+		day    int
+		wantEn string
+		wantFa string
+	}{
+		{1402, Farvardin, 1, "Hormozd", "هرمزد"},
+		{1402, Farvardin, 19, "Farvardin", "فروردین"},
+		{1402, Farvardin, 31, "Hormozd", "هرمزد"},
+		{1402, Ordibehesht, 2, "Ordibehesht", "اردیبهشت"},
+		{1402, Esfand, 24, "Anaram", "انارام"},
+		{1402, Esfand, 25, "Ahunavad", "اهنود"},
+		{1402, Esfand, 29, "Vahishtoisht", "وهیشتوایشت"},
+		{1403, Esfand, 30, "Avardad-sal-gah", "اورداد"},
+	}
+
+	for _, tc := range testCases {
+		j := Date(tc.year, tc.month, tc.day, 0, 0, 0, 0, time.UTC)
+		if got := j.ZoroastrianDayName(); got != tc.wantEn {
+			t.Errorf("%v ZoroastrianDayName() = %v, want %v", j, got, tc.wantEn)
+		}
+		if got := j.ZoroastrianDayFaName(); got != tc.wantFa {
+			t.Errorf("%v ZoroastrianDayFaName() = %v, want %v", j, got, tc.wantFa)
+		}
+	}
+}
+
+func TestZoroastrianDayNameIn(t *testing.T) {
+	testCases := []struct {
+		year   int
+		month  Month
+		day    int
+		r      ZoroastrianReckoning
+		wantEn string
+		wantFa string
+	}{
+		{1402, Farvardin, 1, ReckoningFasli, "Hormozd", "هرمزد"},
+		{1403, Esfand, 30, ReckoningFasli, "Avardad-sal-gah", "اورداد"},
+		// The Shenshai new year fell on August 16, 2023 and, after a
+		// Gregorian leap day, on August 15, 2024.
+		{1402, Mordad, 25, ReckoningShenshai, "Hormozd", "هرمزد"},
+		{1402, Mordad, 24, ReckoningShenshai, "Vahishtoisht", "وهیشتوایشت"},
+		{1403, Mordad, 25, ReckoningShenshai, "Hormozd", "هرمزد"},
+		{1402, Shahrivar, 12, ReckoningShenshai, "Farvardin", "فروردین"},
+		// The Qadimi new year is 30 days earlier, on July 17, 2023.
+		{1402, Tir, 26, ReckoningQadimi, "Hormozd", "هرمزد"},
+		{1402, Tir, 21, ReckoningQadimi, "Ahunavad", "اهنود"},
+		{1402, Mordad, 25, ReckoningQadimi, "Hormozd", "هرمزد"},
+		{1402, Mordad, 26, ReckoningQadimi, "Bahman", "بهمن"},
+		{1000, Farvardin, 1, ReckoningQadimi, "Hormozd", "هرمزد"},
+		{1402, Farvardin, 1, ZoroastrianReckoning(3), "", ""},
+	}
+
+	for _, tc := range testCases {
+		j := Date(tc.year, tc.month, tc.day, 12, 0, 0, 0, time.UTC)
+		if got := j.ZoroastrianDayNameIn(tc.r); got != tc.wantEn {
+			t.Errorf("%v ZoroastrianDayNameIn(%d) = %v, want %v", j, tc.r, got, tc.wantEn)
+		}
+		if got := j.ZoroastrianDayFaNameIn(tc.r); got != tc.wantFa {
+			t.Errorf("%v ZoroastrianDayFaNameIn(%d) = %v, want %v", j, tc.r, got, tc.wantFa)
+		}
+	}
+
+	for _, j := range []JalaliTime{{}, {year: 1402, month: 13, day: 40}, {year: 1402, month: Esfand, day: 30}} {
+		if got := j.ZoroastrianDayName(); got != "" {
+			t.Errorf("%#v ZoroastrianDayName() = %q, want \"\"", j, got)
+		}
+		if got := j.ZoroastrianDayNameIn(ReckoningQadimi); got != "" {
+			t.Errorf("%#v ZoroastrianDayNameIn(ReckoningQadimi) = %q, want \"\"", j, got)
+		}
+	}
+}
@@ -0,0 +1,173 @@
+/*
+MIT License
+
+Copyright (c) 2023 Mohammad Shafiee <muhammad.shafiee@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+
+package jalali
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DateFlag is a command-line flag holding a JalaliDate, for use with
+// flag.Var. It also implements the Type method of spf13/pflag. For example:
+//
+//	var from jalali.DateFlag
+//	flag.Var(&from, "from", "first day of the report")
+//
+// accepts "-from 1402/01/01", "-from ۱۴۰۲/۰۱/۰۱", "-from today" and "-from -7d".
+type DateFlag struct {
+	Date JalaliDate
+
+	// Layouts are the layouts tried in order by Set. If it is empty, Set
+	// accepts the layouts of ParseAny.
+	Layouts []string
+}
+
+// Set parses value as a date in one of f.Layouts, or as an expression of
+// ParseRelative relative to now in the local time zone, of which it keeps
+// the first day. It implements the flag.Value interface.
+func (f *DateFlag) Set(value string) error {
+	j, err := parseFlag(value, f.Layouts, time.Local, "%Y/%m/%d")
+	if err != nil {
+		return err
+	}
+	f.Date = j.Date()
+	return nil
+}
+
+// String returns the date formatted with the first of f.Layouts, or as
+// 1402/01/15, or "" if it is unset. It implements the flag.Value interface.
+func (f *DateFlag) String() string {
+	if f == nil || f.Date.IsZero() {
+		return ""
+	}
+	return f.Date.Format(flagLayout(f.Layouts, "%Y/%m/%d"))
+}
+
+// Get returns the JalaliDate of f. It implements the flag.Getter interface.
+func (f *DateFlag) Get() any {
+	return f.Date
+}
+
+// Type returns the name of the flag's type in spf13/pflag usage messages.
+func (f *DateFlag) Type() string {
+	return "date"
+}
+
+// TimeFlag is a command-line flag holding a JalaliTime, for use with
+// flag.Var. It also implements the Type method of spf13/pflag.
+type TimeFlag struct {
+	Time JalaliTime
+
+	// Layouts are the layouts tried in order by Set. If it is empty, Set
+	// accepts the layouts of ParseAny.
+	Layouts []string
+
+	// Location is the location of times without a zone and of relative
+	// expressions. If it is nil, the local time zone is used.
+	Location *time.Location
+}
+
+// Set parses value as a time in one of f.Layouts, or as an expression of
+// ParseRelative relative to now, of which it keeps the start. It implements
+// the flag.Value interface.
+func (f *TimeFlag) Set(value string) error {
+	loc := f.Location
+	if loc == nil {
+		loc = time.Local
+	}
+	j, err := parseFlag(value, f.Layouts, loc, "%Y/%m/%d %H:%M")
+	if err != nil {
+		return err
+	}
+	f.Time = j
+	return nil
+}
+
+// String returns the time formatted with the first of f.Layouts, or as
+// 1402/01/15 14:30:00, or "" if it is unset. It implements the flag.Value
+// interface.
+func (f *TimeFlag) String() string {
+	if f == nil || f.Time.IsZero() {
+		return ""
+	}
+	return f.Time.Format(flagLayout(f.Layouts, "%Y/%m/%d %H:%M:%S"))
+}
+
+// Get returns the JalaliTime of f. It implements the flag.Getter interface.
+func (f *TimeFlag) Get() any {
+	return f.Time
+}
+
+// Type returns the name of the flag's type in spf13/pflag usage messages.
+func (f *TimeFlag) Type() string {
+	return "time"
+}
+
+// parseFlag parses the value of a DateFlag or TimeFlag. Values in a layout
+// that are not valid dates keep their error; other failures describe what was
+// expected, since the flag package already quotes the value and names the flag.
+func parseFlag(value string, layouts []string, loc *time.Location, def string) (JalaliTime, error) {
+	var (
+		j   JalaliTime
+		err error
+	)
+	if len(layouts) == 0 {
+		j, _, err = ParseAny(value, loc)
+	}
+	for _, layout := range layouts {
+		if j, err = ParseInLocation(layout, value, loc); err == nil || errors.Is(err, ErrInvalidDate) {
+			break
+		}
+	}
+	if err == nil || errors.Is(err, ErrInvalidDate) || errors.Is(err, ErrAmbiguousDate) {
+		return j, err
+	}
+
+	if i, err := ParseRelative(value, Now().In(loc)); err == nil {
+		return i.Start, nil
+	}
+
+	sample := Date(1402, Farvardin, 15, 14, 30, 0, 0, loc).Format(flagLayout(layouts, def))
+	want := fmt.Sprintf("want a date such as %q", sample)
+	if len(layouts) > 0 {
+		quoted := make([]string, len(layouts))
+		for i, layout := range layouts {
+			quoted[i] = strconv.Quote(layout)
+		}
+		want = fmt.Sprintf("want a date in the layout %s, such as %q", strings.Join(quoted, " or "), sample)
+	}
+	return JalaliTime{}, fmt.Errorf(`%s, or a relative date such as "today" or "-7d"`, want)
+}
+
+// flagLayout returns the first of layouts, or def if there are none.
+func flagLayout(layouts []string, def string) string {
+	if len(layouts) > 0 {
+		return layouts[0]
+	}
+	return def
+}
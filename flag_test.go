@@ -0,0 +1,132 @@
+/*
+MIT License
+
+Copyright (c) 2023 Mohammad Shafiee <muhammad.shafiee@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+
+package jalali
+
+import (
+	"errors"
+	"flag"
+	"io"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestDateFlag(t *testing.T) {
+	tests := []struct {
+		value string
+		want  JalaliDate
+	}{
+		{"1402/01/01", JalaliDate{1402, Farvardin, 1}},
+		{"۱۴۰۲/۱۲/۲۹", JalaliDate{1402, Esfand, 29}},
+		{"20 Mordad 1402", JalaliDate{1402, Mordad, 20}},
+		{"today", Today()},
+		{"امروز", Today()},
+		{"-7d", Today().AddDays(-7)},
+	}
+	for _, tc := range tests {
+		var from DateFlag
+		fs := flag.NewFlagSet("test", flag.ContinueOnError)
+		fs.Var(&from, "from", "first day")
+		if err := fs.Parse([]string{"-from", tc.value}); err != nil {
+			t.Errorf("Parse(%q) error = %v", tc.value, err)
+			continue
+		}
+		if from.Date != tc.want {
+			t.Errorf("Parse(%q) = %v, want %v", tc.value, from.Date, tc.want)
+		}
+	}
+
+	f := DateFlag{Date: JalaliDate{1402, Farvardin, 1}}
+	if f.String() != "1402/01/01" || f.Get() != f.Date || f.Type() != "date" {
+		t.Errorf("String(), Get(), Type() = %q, %v, %q", f.String(), f.Get(), f.Type())
+	}
+	if (&DateFlag{}).String() != "" {
+		t.Error("String() of an unset flag is not empty")
+	}
+}
+
+func TestDateFlagLayouts(t *testing.T) {
+	f := DateFlag{Layouts: []string{"%Y-%m-%d", "%d %B %Y"}}
+	if err := f.Set("1402-05-20"); err != nil || f.Date != (JalaliDate{1402, Mordad, 20}) {
+		t.Errorf("Set() = %v, %v", f.Date, err)
+	}
+	if err := f.Set("۲۰ مرداد ۱۴۰۲"); err != nil || f.Date != (JalaliDate{1402, Mordad, 20}) {
+		t.Errorf("Set() = %v, %v", f.Date, err)
+	}
+	if f.String() != "1402-05-20" {
+		t.Errorf("String() = %q", f.String())
+	}
+
+	err := f.Set("1402/05/20")
+	want := `want a date in the layout "%Y-%m-%d" or "%d %B %Y", such as "1402-01-15", or a relative date such as "today" or "-7d"`
+	if err == nil || err.Error() != want {
+		t.Errorf("Set() error = %v, want %s", err, want)
+	}
+}
+
+func TestDateFlagErrors(t *testing.T) {
+	var f DateFlag
+	if err := f.Set("1402/12/30"); !errors.Is(err, ErrInvalidDate) {
+		t.Errorf("Set() error = %v, want ErrInvalidDate", err)
+	}
+	if err := f.Set("05/06/1402"); !errors.Is(err, ErrAmbiguousDate) {
+		t.Errorf("Set() error = %v, want ErrAmbiguousDate", err)
+	}
+	if err := (&TimeFlag{}).Set("999999999h"); err == nil {
+		t.Error("Set() accepted an offset that overflows")
+	}
+
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+	fs.Var(&f, "from", "first day")
+	err := fs.Parse([]string{"-from", "yesterday-ish"})
+	want := `invalid value "yesterday-ish" for flag -from: want a date such as "1402/01/15", or a relative date such as "today" or "-7d"`
+	if err == nil || err.Error() != want {
+		t.Errorf("Parse() error = %v, want %s", err, want)
+	}
+}
+
+func TestTimeFlag(t *testing.T) {
+	tehran := time.FixedZone("IRST", 12600)
+	f := TimeFlag{Location: tehran}
+	if err := f.Set("1402/05/20 16:30"); err != nil || f.Time != Date(1402, Mordad, 20, 16, 30, 0, 0, tehran) {
+		t.Errorf("Set() = %v, %v", f.Time, err)
+	}
+	if f.String() != "1402/05/20 16:30:00" || f.Type() != "time" || f.Get() != f.Time {
+		t.Errorf("String(), Type(), Get() = %q, %q, %v", f.String(), f.Type(), f.Get())
+	}
+
+	if err := f.Set("tomorrow"); err != nil || f.Time.Location() != tehran || f.Time.Date() != Now().In(tehran).Date().AddDays(1) {
+		t.Errorf("Set(tomorrow) = %v, %v", f.Time, err)
+	}
+
+	f = TimeFlag{Layouts: []string{RFC3339}}
+	if err := f.Set("1402-05-20T16:30:00+03:30"); err != nil || !sameInstant(f.Time, Date(1402, Mordad, 20, 16, 30, 0, 0, tehran)) {
+		t.Errorf("Set() = %v, %v", f.Time, err)
+	}
+	if err := f.Set("1402-05-20"); err == nil || !strings.Contains(err.Error(), `"1402-01-15T14:30:00`) {
+		t.Errorf("Set() error = %v", err)
+	}
+}
@@ -0,0 +1,258 @@
+/*
+MIT License
+
+Copyright (c) 2023 Mohammad Shafiee <muhammad.shafiee@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+
+package jalali
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// monthIdents and weekdayIdents are the names of the Month and Weekday constants.
+var (
+	monthIdents = [...]string{"", "Farvardin", "Ordibehesht", "Khordad", "Tir", "Mordad", "Shahrivar",
+		"Mehr", "Aban", "Azar", "Dey", "Bahman", "Esfand"}
+	weekdayIdents = [...]string{"Yekshanbe", "Doshanbe", "Seshanbe", "Chaharshanbe", "Panjshanbe", "Joomeh", "Shanbe"}
+)
+
+// GoString returns j as a Go expression that recreates it, such as
+// jalali.Date(1402, jalali.Mordad, 20, 16, 30, 0, 0, time.UTC), for %#v and
+// test fixtures. UTC, the local time zone and Tehran are written as such, and
+// other locations as a time.FixedZone with the offset in effect at j.
+//
+// JalaliTime cannot implement fmt.Formatter, since its Format method formats
+// with a layout; format j.Pretty() for %+v with the zone and the Gregorian date.
+func (j JalaliTime) GoString() string {
+	if j.IsZero() {
+		return "jalali.JalaliTime{}"
+	}
+	return fmt.Sprintf("jalali.Date(%d, %s, %d, %d, %d, %d, %d, %s)",
+		j.year, j.month.GoString(), j.day, j.hour, j.min, j.sec, j.nsec, goLocation(j))
+}
+
+// PrettyTime is a JalaliTime that implements fmt.Formatter. %v and %s write
+// String, %+v adds the zone and the Gregorian equivalent, as in
+// "1402/05/20 16:30:00 +0330 IRST (2023-08-11 16:30:00)", %#v writes
+// GoString and %q the quoted String.
+type PrettyTime JalaliTime
+
+// Pretty returns j as a PrettyTime, for formatting with the fmt package.
+func (j JalaliTime) Pretty() PrettyTime {
+	return PrettyTime(j)
+}
+
+// Format implements the fmt.Formatter interface.
+func (p PrettyTime) Format(f fmt.State, verb rune) {
+	j := JalaliTime(p)
+	switch {
+	case verb == 'v' && f.Flag('#'):
+		fmtString(f, j.GoString())
+	case verb == 'v' && f.Flag('+') && !j.IsZero():
+		fmtString(f, j.String()+" "+j.Format("%z %Z")+" ("+j.ToTime().Format("2006-01-02 15:04:05")+")")
+	case verb == 'v' || verb == 's':
+		fmtString(f, j.String())
+	case verb == 'q':
+		fmt.Fprintf(f, fmtDirective(f, verb), j.String())
+	default:
+		fmt.Fprintf(f, "%%!%c(jalali.PrettyTime=%s)", verb, j.String())
+	}
+}
+
+// goLocation returns a Go expression for the location of j.
+func goLocation(j JalaliTime) string {
+	switch {
+	case j.loc == nil || j.loc == time.Local:
+		return "time.Local"
+	case j.loc == time.UTC || j.loc.String() == "UTC":
+		return "time.UTC"
+	case j.loc.String() == "Asia/Tehran":
+		return "jalali.Tehran()"
+	}
+	name, offset := j.Zone()
+	return fmt.Sprintf("time.FixedZone(%q, %d)", name, offset)
+}
+
+// GoString returns m as a Go expression, such as jalali.Mordad.
+func (m Month) GoString() string {
+	if m < Farvardin || m > Esfand {
+		return "jalali.Month(" + strconv.Itoa(int(m)) + ")"
+	}
+	return "jalali." + monthIdents[m]
+}
+
+// Format implements the fmt.Formatter interface. %v and %s write the English
+// name, %+v adds the number and the Persian name, as in "Mordad (5, مرداد)",
+// %#v writes GoString and %q the quoted name. Other verbs format the number.
+func (m Month) Format(f fmt.State, verb rune) {
+	if m < Farvardin || m > Esfand {
+		formatEnum(f, verb, int(m), "Month("+strconv.Itoa(int(m))+")", "", m.GoString())
+		return
+	}
+	formatEnum(f, verb, int(m), m.String(), " ("+strconv.Itoa(int(m))+", "+m.FaString()+")", m.GoString())
+}
+
+// GoString returns w as a Go expression, such as jalali.Shanbe.
+func (w Weekday) GoString() string {
+	if w < Yekshanbe || w > Shanbe {
+		return "jalali.Weekday(" + strconv.Itoa(int(w)) + ")"
+	}
+	return "jalali." + weekdayIdents[w]
+}
+
+// Format implements the fmt.Formatter interface. %v and %s write the English
+// name, %+v adds the Persian name, as in "Shanbeh (شنبه)", %#v writes GoString
+// and %q the quoted name. Other verbs format the number.
+func (w Weekday) Format(f fmt.State, verb rune) {
+	if w < Yekshanbe || w > Shanbe {
+		formatEnum(f, verb, int(w), "Weekday("+strconv.Itoa(int(w))+")", "", w.GoString())
+		return
+	}
+	formatEnum(f, verb, int(w), w.String(), " ("+w.FaString()+")", w.GoString())
+}
+
+// formatEnum formats a Month or Weekday whose number is n, English name is
+// name, %+v suffix is plus and Go expression is goName.
+func formatEnum(f fmt.State, verb rune, n int, name, plus, goName string) {
+	switch {
+	case verb == 'v' && f.Flag('#'):
+		fmtString(f, goName)
+	case verb == 'v' && f.Flag('+'):
+		fmtString(f, name+plus)
+	case verb == 'v' || verb == 's':
+		fmtString(f, name)
+	case verb == 'q' || verb == 'x' || verb == 'X':
+		// As for a fmt.Stringer, %q, %x and %X format the name.
+		fmt.Fprintf(f, fmtDirective(f, verb), name)
+	default:
+		fmt.Fprintf(f, fmtDirective(f, verb), n)
+	}
+}
+
+// String returns d in the ISO 8601 form of a duration, such as "P1Y2M3D",
+// with zero fields left out. A duration whose fields are all zero or negative
+// takes a leading sign, as in "-P1Y3D"; ISO 8601 cannot express mixed signs,
+// so those are written on each field, as in "P1Y-2M". The zero
+// JalaliDuration is "P0D".
+func (d JalaliDuration) String() string {
+	if d == (JalaliDuration{}) {
+		return "P0D"
+	}
+	var b []byte
+	sign := 1
+	if d.Years <= 0 && d.Months <= 0 && d.Days <= 0 {
+		b = append(b, '-')
+		sign = -1
+	}
+	b = append(b, 'P')
+	for _, part := range [...]struct {
+		n    int
+		unit byte
+	}{{d.Years, 'Y'}, {d.Months, 'M'}, {d.Days, 'D'}} {
+		if part.n != 0 {
+			b = strconv.AppendInt(b, int64(sign*part.n), 10)
+			b = append(b, part.unit)
+		}
+	}
+	return string(b)
+}
+
+// GoString returns d as a Go expression, such as
+// jalali.JalaliDuration{Years:1, Months:2, Days:3}.
+func (d JalaliDuration) GoString() string {
+	return fmt.Sprintf("jalali.JalaliDuration{Years:%d, Months:%d, Days:%d}", d.Years, d.Months, d.Days)
+}
+
+// Format implements the fmt.Formatter interface. %v writes the fields as
+// before, as in "{1 2 3}", %s writes String, %+v writes the duration in
+// English words, as in "1 year, 2 months, 3 days", %#v writes GoString and %q
+// the quoted String. Other verbs format the fields.
+func (d JalaliDuration) Format(f fmt.State, verb rune) {
+	switch {
+	case verb == 'v' && f.Flag('#'):
+		fmtString(f, d.GoString())
+	case verb == 'v' && f.Flag('+'):
+		fmtString(f, d.words())
+	case verb == 's':
+		fmtString(f, d.String())
+	case verb == 'q':
+		fmt.Fprintf(f, fmtDirective(f, verb), d.String())
+	default:
+		fmt.Fprintf(f, fmtDirective(f, verb), struct{ Years, Months, Days int }(d))
+	}
+}
+
+// words returns d in English words, with zero fields left out.
+func (d JalaliDuration) words() string {
+	var parts []string
+	for _, part := range [...]struct {
+		n    int
+		unit string
+	}{{d.Years, "year"}, {d.Months, "month"}, {d.Days, "day"}} {
+		if part.n == 0 {
+			continue
+		}
+		s := strconv.Itoa(part.n) + " " + part.unit
+		if part.n != 1 && part.n != -1 {
+			s += "s"
+		}
+		parts = append(parts, s)
+	}
+	if len(parts) == 0 {
+		return "0 days"
+	}
+	return strings.Join(parts, ", ")
+}
+
+// fmtString writes s with the width and the '-' flag of f.
+func fmtString(f fmt.State, s string) {
+	directive := "%"
+	if f.Flag('-') {
+		directive += "-"
+	}
+	if w, ok := f.Width(); ok {
+		directive += strconv.Itoa(w)
+	}
+	fmt.Fprintf(f, directive+"s", s)
+}
+
+// fmtDirective rebuilds the directive of f for verb, such as "%-8q".
+func fmtDirective(f fmt.State, verb rune) string {
+	b := []byte{'%'}
+	for _, flag := range "+-# 0" {
+		if f.Flag(int(flag)) {
+			b = append(b, byte(flag))
+		}
+	}
+	if w, ok := f.Width(); ok {
+		b = strconv.AppendInt(b, int64(w), 10)
+	}
+	if p, ok := f.Precision(); ok {
+		b = append(b, '.')
+		b = strconv.AppendInt(b, int64(p), 10)
+	}
+	return string(utf8.AppendRune(b, verb))
+}
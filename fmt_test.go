@@ -0,0 +1,153 @@
+/*
+MIT License
+
+Copyright (c) 2023 Mohammad Shafiee <muhammad.shafiee@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+
+package jalali
+
+import (
+	"fmt"
+	"testing"
+	"time"
+)
+
+func TestJalaliTimeGoString(t *testing.T) {
+	tests := []struct {
+		j    JalaliTime
+		want string
+	}{
+		{Date(1402, Mordad, 20, 16, 30, 0, 0, time.UTC), "jalali.Date(1402, jalali.Mordad, 20, 16, 30, 0, 0, time.UTC)"},
+		{Date(1402, Mordad, 20, 16, 30, 0, 5, time.Local), "jalali.Date(1402, jalali.Mordad, 20, 16, 30, 0, 5, time.Local)"},
+		{Date(1402, Esfand, 29, 8, 0, 0, 0, Tehran()), "jalali.Date(1402, jalali.Esfand, 29, 8, 0, 0, 0, jalali.Tehran())"},
+		{Date(1402, Mordad, 20, 16, 30, 0, 0, time.FixedZone("IRST", 12600)), `jalali.Date(1402, jalali.Mordad, 20, 16, 30, 0, 0, time.FixedZone("IRST", 12600))`},
+		{JalaliTime{}, "jalali.JalaliTime{}"},
+	}
+	for _, tc := range tests {
+		if got := fmt.Sprintf("%#v", tc.j); got != tc.want {
+			t.Errorf("%%#v = %s, want %s", got, tc.want)
+		}
+	}
+
+	j := Date(1402, Mordad, 20, 16, 30, 0, 0, time.UTC)
+	if got := fmt.Sprintf("%v|%q", j, j); got != j.String()+"|"+`"`+j.String()+`"` {
+		t.Errorf("%%v|%%q = %s", got)
+	}
+}
+
+func TestMonthFormatter(t *testing.T) {
+	tests := []struct {
+		format string
+		m      Month
+		want   string
+	}{
+		{"%v", Mordad, "Mordad"},
+		{"%s", Mordad, "Mordad"},
+		{"%+v", Mordad, "Mordad (5, مرداد)"},
+		{"%#v", Mordad, "jalali.Mordad"},
+		{"%q", Mordad, `"Mordad"`},
+		{"%d", Mordad, "5"},
+		{"%02d", Mordad, "05"},
+		{"%x", Tir, "546972"},
+		{"[%-8v]", Tir, "[Tir     ]"},
+		{"[%8v]", Tir, "[     Tir]"},
+		{"%v", Month(13), "Month(13)"},
+		{"%#v", Month(0), "jalali.Month(0)"},
+	}
+	for _, tc := range tests {
+		if got := fmt.Sprintf(tc.format, tc.m); got != tc.want {
+			t.Errorf("Sprintf(%q, %d) = %q, want %q", tc.format, int(tc.m), got, tc.want)
+		}
+	}
+}
+
+func TestWeekdayFormatter(t *testing.T) {
+	tests := []struct {
+		format string
+		w      Weekday
+		want   string
+	}{
+		{"%v", Shanbe, "Shanbeh"},
+		{"%+v", Shanbe, "Shanbeh (شنبه)"},
+		{"%#v", Shanbe, "jalali.Shanbe"},
+		{"%#v", Chaharshanbe, "jalali.Chaharshanbe"},
+		{"%q", Joomeh, `"Joomeh"`},
+		{"%d", Joomeh, "5"},
+		{"%v", Weekday(7), "Weekday(7)"},
+	}
+	for _, tc := range tests {
+		if got := fmt.Sprintf(tc.format, tc.w); got != tc.want {
+			t.Errorf("Sprintf(%q, %d) = %q, want %q", tc.format, int(tc.w), got, tc.want)
+		}
+	}
+}
+
+func TestJalaliDurationFormatter(t *testing.T) {
+	tests := []struct {
+		format string
+		d      JalaliDuration
+		want   string
+	}{
+		{"%v", JalaliDuration{1, 2, 3}, "{1 2 3}"},
+		{"%v", JalaliDuration{-1, 0, -3}, "{-1 0 -3}"},
+		{"%s", JalaliDuration{1, 2, 3}, "P1Y2M3D"},
+		{"%s", JalaliDuration{Months: 1}, "P1M"},
+		{"%s", JalaliDuration{-1, 0, -3}, "-P1Y3D"},
+		{"%s", JalaliDuration{1, -2, 0}, "P1Y-2M"},
+		{"%s", JalaliDuration{}, "P0D"},
+		{"%+v", JalaliDuration{1, 2, 3}, "1 year, 2 months, 3 days"},
+		{"%+v", JalaliDuration{Days: -1}, "-1 day"},
+		{"%+v", JalaliDuration{}, "0 days"},
+		{"%#v", JalaliDuration{1, 2, 3}, "jalali.JalaliDuration{Years:1, Months:2, Days:3}"},
+		{"%q", JalaliDuration{Days: 10}, `"P10D"`},
+		{"%d", JalaliDuration{1, 2, 3}, "{1 2 3}"},
+	}
+	for _, tc := range tests {
+		if got := fmt.Sprintf(tc.format, tc.d); got != tc.want {
+			t.Errorf("Sprintf(%q, %v) = %q, want %q", tc.format, tc.d.String(), got, tc.want)
+		}
+	}
+}
+
+func TestPrettyTimeFormatter(t *testing.T) {
+	j := Date(1402, Mordad, 20, 16, 30, 0, 0, time.FixedZone("IRST", 12600))
+	tests := []struct {
+		format string
+		p      PrettyTime
+		want   string
+	}{
+		{"%v", j.Pretty(), "1402/05/20 16:30:00"},
+		{"%s", j.Pretty(), "1402/05/20 16:30:00"},
+		{"%+v", j.Pretty(), "1402/05/20 16:30:00 +0330 IRST (2023-08-11 16:30:00)"},
+		{"%+v", Date(1402, Dey, 1, 0, 0, 0, 0, time.UTC).Pretty(), "1402/10/01 00:00:00 +0000 UTC (2023-12-22 00:00:00)"},
+		{"%#v", j.Pretty(), `jalali.Date(1402, jalali.Mordad, 20, 16, 30, 0, 0, time.FixedZone("IRST", 12600))`},
+		{"%q", j.Pretty(), `"1402/05/20 16:30:00"`},
+		{"[%-21q]", j.Pretty(), `["1402/05/20 16:30:00"]`},
+		{"[%-22q]", j.Pretty(), `["1402/05/20 16:30:00" ]`},
+		{"%+v", JalaliTime{}.Pretty(), JalaliTime{}.String()},
+		{"%d", j.Pretty(), "%!d(jalali.PrettyTime=1402/05/20 16:30:00)"},
+	}
+	for _, tc := range tests {
+		if got := fmt.Sprintf(tc.format, tc.p); got != tc.want {
+			t.Errorf("Sprintf(%q) = %q, want %q", tc.format, got, tc.want)
+		}
+	}
+}
@@ -0,0 +1,243 @@
+/*
+MIT License
+
+Copyright (c) 2023 Mohammad Shafiee <muhammad.shafiee@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+
+package jalali
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// HumanizeThresholds controls when Humanize moves from one unit to the next.
+type HumanizeThresholds struct {
+	// JustNow is the difference below which a time is described as "just now".
+	JustNow time.Duration
+
+	// Minutes is the difference below which a time is counted in minutes.
+	Minutes time.Duration
+
+	// Days is the number of calendar days below which a time is counted in days.
+	Days int
+
+	// Weeks is the number of calendar weeks below which a time is counted in weeks.
+	Weeks int
+
+	// Months is the number of calendar months below which a time is counted in
+	// months. Longer differences are counted in years.
+	Months int
+}
+
+// DefaultHumanizeThresholds are the thresholds used by Humanize.
+var DefaultHumanizeThresholds = HumanizeThresholds{
+	JustNow: 45 * time.Second,
+	Minutes: time.Hour,
+	Days:    7,
+	Weeks:   4,
+	Months:  12,
+}
+
+// relativeWords contains the phrases used to describe a time relative to now.
+type relativeWords struct {
+	justNow   string
+	yesterday string
+	tomorrow  string // formatted with the clock time
+	past      string // formatted with the count and unit
+	future    string // formatted with the count and unit
+	units     [6][2]string
+	last      [3]string // last week, month and year
+	next      [3]string // next week, month and year
+	persian   bool      // write Persian digits
+}
+
+// Units of relativeWords.units, and of relativeWords.last and next from unitWeek
+// on. ParseRelative uses them for the units of expressions.
+const (
+	unitMinute = iota
+	unitHour
+	unitDay
+	unitWeek
+	unitMonth
+	unitYear
+)
+
+var faRelativeWords = &relativeWords{
+	justNow:   "همین الان",
+	yesterday: "دیروز",
+	tomorrow:  "فردا ساعت %s",
+	past:      "%s پیش",
+	future:    "%s دیگر",
+	units: [6][2]string{
+		{"دقیقه", "دقیقه"}, {"ساعت", "ساعت"}, {"روز", "روز"},
+		{"هفته", "هفته"}, {"ماه", "ماه"}, {"سال", "سال"},
+	},
+	last:    [3]string{"هفته‌ی پیش", "ماه پیش", "پارسال"},
+	next:    [3]string{"هفته‌ی بعد", "ماه بعد", "سال بعد"},
+	persian: true,
+}
+
+var enRelativeWords = &relativeWords{
+	justNow:   "just now",
+	yesterday: "yesterday",
+	tomorrow:  "tomorrow at %s",
+	past:      "%s ago",
+	future:    "in %s",
+	units: [6][2]string{
+		{"minute", "minutes"}, {"hour", "hours"}, {"day", "days"},
+		{"week", "weeks"}, {"month", "months"}, {"year", "years"},
+	},
+	last: [3]string{"last week", "last month", "last year"},
+	next: [3]string{"next week", "next month", "next year"},
+}
+
+// Humanize describes t relative to now in the language of the given locale, e.g.
+// "۳ روز پیش", "دیروز", "فردا ساعت ۱۰", "هفته‌ی بعد" or "in 2 months".
+// It uses DefaultHumanizeThresholds. See HumanizeThresholds.Humanize.
+//
+// Only Persian and English phrases are available. LocalePersian and
+// LocaleDari get Persian; every other locale, including LocalePashto,
+// LocaleSorani and LocaleKurmanji, gets English.
+func Humanize(t, now JalaliTime, l *Locale) string {
+	return DefaultHumanizeThresholds.Humanize(t, now, l)
+}
+
+// Humanize describes t relative to now in the language of the given locale.
+// Days, weeks, months and years are counted by calendar boundaries in the
+// location of now: "yesterday" is the previous Jalali civil day, not 24 hours
+// ago, and weeks start on Saturday. Persian phrases are used for locales whose
+// tag starts with "fa", and English phrases for all other locales.
+func (h HumanizeThresholds) Humanize(t, now JalaliTime, l *Locale) string {
+	words := enRelativeWords
+	if strings.HasPrefix(l.Tag, "fa") {
+		words = faRelativeWords
+	}
+
+	t = t.In(now.Location())
+	d := t.Sub(now)
+	abs := d
+	if abs < 0 {
+		abs = -abs
+	}
+
+	if abs < h.JustNow {
+		return words.justNow
+	}
+	if abs < h.Minutes {
+		return words.count(d < 0, unitMinute, int(abs/time.Minute))
+	}
+
+	days := civilDay(t) - civilDay(now)
+	switch {
+	case days == 0:
+		return words.count(d < 0, unitHour, int(abs/time.Hour))
+	case days == -1:
+		return words.yesterday
+	case days == 1:
+		return fmt.Sprintf(words.tomorrow, words.clock(t.hour, t.min))
+	case absInt(days) < h.Days:
+		return words.count(days < 0, unitDay, absInt(days))
+	}
+
+	// Weeks start on Saturday; day 0 of civilDay is a Thursday. Times in the
+	// same month as now are counted in weeks even past h.Weeks.
+	weeks := floorDiv(civilDay(t)+5, 7) - floorDiv(civilDay(now)+5, 7)
+	months := (t.year*12 + int(t.month)) - (now.year*12 + int(now.month))
+	if absInt(weeks) < h.Weeks || months == 0 {
+		return words.relative(days < 0, unitWeek, absInt(weeks))
+	}
+
+	if absInt(months) < h.Months {
+		return words.relative(days < 0, unitMonth, absInt(months))
+	}
+
+	return words.relative(days < 0, unitYear, absInt(t.year-now.year))
+}
+
+// relative describes n units in the past or future, using the "last" and
+// "next" phrases for a single unit.
+func (w *relativeWords) relative(past bool, unit, n int) string {
+	if n <= 1 {
+		if past {
+			return w.last[unit-unitWeek]
+		}
+		return w.next[unit-unitWeek]
+	}
+	return w.count(past, unit, n)
+}
+
+// count describes n units in the past or future.
+func (w *relativeWords) count(past bool, unit, n int) string {
+	if n < 1 {
+		n = 1
+	}
+	name := w.units[unit][1]
+	if n == 1 {
+		name = w.units[unit][0]
+	}
+	phrase := w.digits(strconv.Itoa(n)) + " " + name
+	if past {
+		return fmt.Sprintf(w.past, phrase)
+	}
+	return fmt.Sprintf(w.future, phrase)
+}
+
+// clock returns the time of day; Persian phrases omit zero minutes ("ساعت ۱۰").
+func (w *relativeWords) clock(hour, min int) string {
+	if w.persian && min == 0 {
+		return w.digits(strconv.Itoa(hour))
+	}
+	return w.digits(fmt.Sprintf("%02d:%02d", hour, min))
+}
+
+// digits writes the digits of s in the script of the phrases.
+func (w *relativeWords) digits(s string) string {
+	if w.persian {
+		return ToPersianDigits(s)
+	}
+	return s
+}
+
+// civilDay returns the number of days from January 1, 1970 to the date of j.
+func civilDay(j JalaliTime) int {
+	gYear, gMonth, gDay := jalaliToGregorian(j.year, j.month, j.day)
+	return int(time.Date(gYear, gMonth, gDay, 0, 0, 0, 0, time.UTC).Unix() / 86400)
+}
+
+// floorDiv returns a/b rounded towards negative infinity.
+func floorDiv(a, b int) int {
+	q := a / b
+	if (a%b != 0) && ((a < 0) != (b < 0)) {
+		q--
+	}
+	return q
+}
+
+// absInt returns the absolute value of n.
+func absInt(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
@@ -0,0 +1,122 @@
+/*
+MIT License
+
+Copyright (c) 2023 Mohammad Shafiee <muhammad.shafiee@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+
+package jalali
+
+import (
+	"testing"
+	"time"
+)
+
+func TestHumanize(t *testing.T) {
+	// 1402/05/20 is a Friday.
+	now := Date(1402, Mordad, 20, 16, 30, 0, 0, time.UTC)
+
+	testCases := []struct {
+		t      JalaliTime
+		locale *Locale
+		want   string
+	}{
+		{now.Add(-10 * time.Second), LocalePersian, "همین الان"},
+		{now.Add(-5 * time.Minute), LocalePersian, "۵ دقیقه پیش"},
+		{now.Add(-5 * time.Minute), LocaleEnglish, "5 minutes ago"},
+		{now.Add(time.Minute), LocaleEnglish, "in 1 minute"},
+		{now.Add(3 * time.Hour), LocalePersian, "۳ ساعت دیگر"},
+		{Date(1402, Mordad, 19, 23, 59, 0, 0, time.UTC), LocalePersian, "دیروز"},
+		{Date(1402, Mordad, 19, 23, 59, 0, 0, time.UTC), LocaleEnglish, "yesterday"},
+		{Date(1402, Mordad, 21, 10, 0, 0, 0, time.UTC), LocalePersian, "فردا ساعت ۱۰"},
+		{Date(1402, Mordad, 21, 10, 30, 0, 0, time.UTC), LocaleEnglish, "tomorrow at 10:30"},
+		{Date(1402, Mordad, 17, 9, 0, 0, 0, time.UTC), LocalePersian, "۳ روز پیش"},
+		{Date(1402, Mordad, 23, 9, 0, 0, 0, time.UTC), LocaleEnglish, "in 3 days"},
+		{Date(1402, Mordad, 27, 9, 0, 0, 0, time.UTC), LocalePersian, "هفته‌ی بعد"},
+		{Date(1402, Mordad, 28, 9, 0, 0, 0, time.UTC), LocalePersian, "۲ هفته دیگر"},
+		{Date(1402, Mordad, 12, 9, 0, 0, 0, time.UTC), LocaleEnglish, "last week"},
+		{Date(1402, Shahrivar, 5, 9, 0, 0, 0, time.UTC), LocaleEnglish, "in 3 weeks"},
+		{Date(1402, Mehr, 25, 9, 0, 0, 0, time.UTC), LocaleEnglish, "in 2 months"},
+		{Date(1402, Farvardin, 25, 9, 0, 0, 0, time.UTC), LocalePersian, "۴ ماه پیش"},
+		{Date(1401, Farvardin, 25, 9, 0, 0, 0, time.UTC), LocalePersian, "پارسال"},
+		{Date(1405, Farvardin, 25, 9, 0, 0, 0, time.UTC), LocaleEnglish, "in 3 years"},
+	}
+
+	for _, tc := range testCases {
+		if got := Humanize(tc.t, now, tc.locale); got != tc.want {
+			t.Errorf("Humanize(%v, %v, %s) = %v, want %v", tc.t, now, tc.locale.Tag, got, tc.want)
+		}
+	}
+}
+
+func TestHumanizeCalendarBoundaries(t *testing.T) {
+	tehran := time.FixedZone("IRST", 12600)
+	now := Date(1402, Mordad, 20, 0, 30, 0, 0, tehran)
+
+	// Two hours earlier is the previous civil day in Tehran.
+	if got := Humanize(now.Add(-2*time.Hour), now, LocaleEnglish); got != "yesterday" {
+		t.Errorf("Humanize() = %v, want %v", got, "yesterday")
+	}
+
+	// The same instant in UTC is still the same civil day once moved to Tehran.
+	if got := Humanize(now.Add(-20*time.Minute).UTC(), now, LocaleEnglish); got != "20 minutes ago" {
+		t.Errorf("Humanize() = %v, want %v", got, "20 minutes ago")
+	}
+
+	thresholds := DefaultHumanizeThresholds
+	thresholds.Days = 30
+	if got := thresholds.Humanize(now.AddDays(-10), now, LocaleEnglish); got != "10 days ago" {
+		t.Errorf("Humanize() with custom thresholds = %v, want %v", got, "10 days ago")
+	}
+}
+
+func TestHumanizeSameMonth(t *testing.T) {
+	now := Date(1402, Farvardin, 1, 12, 0, 0, 0, time.UTC)
+	for _, day := range []int{26, 29, 31} {
+		later := Date(1402, Farvardin, day, 12, 0, 0, 0, time.UTC)
+		if got := Humanize(later, now, LocaleEnglish); got != "in 4 weeks" {
+			t.Errorf("Humanize(1402/01/%02d) = %v, want %v", day, got, "in 4 weeks")
+		}
+		if got := Humanize(now, later, LocaleEnglish); got != "4 weeks ago" {
+			t.Errorf("Humanize() from 1402/01/%02d = %v, want %v", day, got, "4 weeks ago")
+		}
+	}
+}
+
+func TestHumanizeLocaleFallback(t *testing.T) {
+	now := Date(1402, Mordad, 20, 16, 30, 0, 0, time.UTC)
+	then := Date(1402, Mordad, 17, 9, 0, 0, 0, time.UTC)
+
+	if got := Humanize(then, now, LocaleDari); got != "۳ روز پیش" {
+		t.Errorf("Humanize() in %s = %v, want %v", LocaleDari.Tag, got, "۳ روز پیش")
+	}
+	// Locales without their own phrases are written in English.
+	for _, l := range []*Locale{LocalePashto, LocaleSorani, LocaleKurmanji, LocaleAfghanEnglish} {
+		if got := Humanize(then, now, l); got != "3 days ago" {
+			t.Errorf("Humanize() in %s = %v, want %v", l.Tag, got, "3 days ago")
+		}
+	}
+}
+
+func TestToPersianDigits(t *testing.T) {
+	if got, want := ToPersianDigits("1402/05/20 ساعت 10"), "۱۴۰۲/۰۵/۲۰ ساعت ۱۰"; got != want {
+		t.Errorf("ToPersianDigits() = %v, want %v", got, want)
+	}
+}
@@ -26,12 +26,10 @@ THE SOFTWARE.
 package jalali
 
 import (
-	"errors"
 	"fmt"
+	"io"
 	"math"
-	"regexp"
-	"strconv"
-	"strings"
+	"sync"
 	"time"
 )
 
@@ -379,6 +377,7 @@ func (j JalaliTime) UnixNano() int64 {
 
 // Format returns a string representing the Jalali time formatted according to the layout string.
 // The layout string uses format specifiers similar to strftime, starting with % followed by a letter.
+// Names are written in Persian; use FormatLocale for other languages.
 // Supported specifiers:
 //
 //	%Y: year as a 4-digit number (e.g., 1402)
@@ -388,89 +387,83 @@ func (j JalaliTime) UnixNano() int64 {
 //	%b: abbreviated month name in Persian
 //	%d: day of the month as a 2-digit number (01-31)
 //	%H: hour (00-23)
+//	%I: hour on a 12-hour clock (01-12), used with %p
 //	%M: minute (00-59)
 //	%S: second (00-59)
+//	%f: fractional seconds as nanoseconds (000000000-999999999)
 //	%p: "AM" or "PM" in Persian ("صبح" or "عصر")
 //	%w: weekday name in Persian
 //	%z: time zone offset as ±hhmm
+//	%:z: time zone offset as ±hh:mm, as in RFC 3339
 //	%Z: time zone name
 //	%R: 24-hour time in the format "HH:MM"
 //	%T: time in the format "HH:MM:SS"
+//	%Od: day of the month as a Persian ordinal word (e.g., بیستم)
+//	%OY: year in Persian words (e.g., یک هزار و چهارصد و دو)
 //	%%: percent sign
+//
+// The markers %[ and %] enclose an optional section of a layout. They write
+// nothing; see ParseInLocation.
 func (j JalaliTime) Format(layout string) string {
-	var builder strings.Builder
-	length := len(layout)
-	i := 0
-
-	for i < length {
-		if layout[i] == '%' && i+1 < length {
-			specifier := layout[i : i+2]
-			switch specifier {
-			case "%n":
-				builder.WriteByte('\n')
-			case "%%":
-				builder.WriteByte('%')
-			case "%Y":
-				builder.WriteString(fmt.Sprintf("%04d", j.year))
-			case "%y":
-				builder.WriteString(fmt.Sprintf("%02d", j.year%100))
-			case "%m":
-				builder.WriteString(fmt.Sprintf("%02d", j.month))
-			case "%B":
-				builder.WriteString(FaJalaliMonthName[j.month])
-			case "%b":
-				// Abbreviated month name, take first three characters
-				if len(FaJalaliMonthName[j.month]) >= 3 {
-					builder.WriteString(FaJalaliMonthName[j.month][:3])
-				} else {
-					builder.WriteString(FaJalaliMonthName[j.month])
-				}
-			case "%d":
-				builder.WriteString(fmt.Sprintf("%02d", j.day))
-			case "%H":
-				builder.WriteString(fmt.Sprintf("%02d", j.hour))
-			case "%M":
-				builder.WriteString(fmt.Sprintf("%02d", j.min))
-			case "%S":
-				builder.WriteString(fmt.Sprintf("%02d", j.sec))
-			case "%p":
-				if j.hour < 12 {
-					builder.WriteString("صبح") // AM in Persian
-				} else {
-					builder.WriteString("عصر") // PM in Persian
-				}
-			case "%w":
-				builder.WriteString(FaWeekDays[j.Weekday()])
-			case "%z":
-				_, offset := j.Zone()
-				sign := "+"
-				if offset < 0 {
-					sign = "-"
-					offset = -offset
-				}
-				hours := offset / 3600
-				minutes := (offset % 3600) / 60
-				builder.WriteString(fmt.Sprintf("%s%02d%02d", sign, hours, minutes))
-			case "%Z":
-				if j.loc != nil {
-					builder.WriteString(j.loc.String())
-				}
-			case "%R":
-				builder.WriteString(fmt.Sprintf("%02d:%02d", j.hour, j.min))
-			case "%T":
-				builder.WriteString(fmt.Sprintf("%02d:%02d:%02d", j.hour, j.min, j.sec))
-			default:
-				// Unknown specifier, write as-is
-				builder.WriteString(specifier)
-			}
-			i += 2
-		} else {
-			builder.WriteByte(layout[i])
-			i++
+	return j.FormatLocale(layout, LocalePersian)
+}
+
+// FormatLocale is like Format, but writes month names, weekday names and the
+// AM/PM markers of the given locale, and numbers years in the locale's era.
+func (j JalaliTime) FormatLocale(layout string, l *Locale) string {
+	const bufSize = 64
+	var buf [bufSize]byte
+	return string(j.appendFormat(buf[:0], layout, l))
+}
+
+// AppendFormat is like Format but appends the text to b and returns the
+// extended buffer. Like time.Time.AppendFormat, it does not allocate unless b
+// is too small, or the layout writes numbers in words with %Od or %OY.
+func (j JalaliTime) AppendFormat(b []byte, layout string) []byte {
+	return j.appendFormat(b, layout, LocalePersian)
+}
+
+// WriteTo writes j formatted according to layout to w, and returns the number
+// of bytes written and any error encountered. See Format. It formats into a
+// pooled buffer, so like AppendFormat it does not allocate.
+func (j JalaliTime) WriteTo(w io.Writer, layout string) (int64, error) {
+	bp := writeBufPool.Get().(*[]byte)
+	b := j.appendFormat((*bp)[:0], layout, LocalePersian)
+	n, err := w.Write(b)
+	// Buffers that grew for an unusually long layout are left to the garbage collector.
+	if cap(b) <= maxPooledBuf {
+		*bp = b
+		writeBufPool.Put(bp)
+	}
+	return int64(n), err
+}
+
+// maxPooledBuf is the capacity above which WriteTo does not return a buffer to
+// writeBufPool.
+const maxPooledBuf = 1 << 10
+
+// writeBufPool holds the buffers WriteTo formats into. A buffer passed to
+// io.Writer.Write escapes to the heap, so a local array would be allocated
+// on every call.
+var writeBufPool = sync.Pool{
+	New: func() any {
+		b := make([]byte, 0, 64)
+		return &b
+	},
+}
+
+// appendFormat appends j formatted according to layout in the locale l to b.
+func (j JalaliTime) appendFormat(b []byte, layout string, l *Locale) []byte {
+	for i := 0; i < len(layout); i++ {
+		if layout[i] != '%' || i == len(layout)-1 {
+			b = append(b, layout[i])
+			continue
 		}
+		spec := specifier(layout[i:])
+		b = appendSpec(b, j, spec, l)
+		i += len(spec) - 1
 	}
-
-	return builder.String()
+	return b
 }
 
 // FormatShort returns the JalaliTime formatted as a short string in the format "YYYY/MM/DD".
@@ -749,54 +742,6 @@ func (j JalaliTime) DaysUntil(targetDate JalaliTime) int {
 	return int(jd2 - jd1)
 }
 
-// ParseInLocation function takes a layout and a value as input and returns a JalaliTime and an error.
-// The layout parameter defines the format of the value parameter in a similar way to the standard
-// library's time.Parse function.
-// The function uses regular expressions to match the format defined by the layout and extract the relevant values.
-// It then validates the Jalali date and returns a JalaliTime object with the parsed values.
-// If the value cannot be parsed or the Jalali date is invalid, an error is returned.
-func ParseInLocation(layout, value string, loc *time.Location) (JalaliTime, error) {
-	var year, month, day, hour, min, sec int
-
-	re := regexp.MustCompile(`%([YymdHMS])`)
-	layout = re.ReplaceAllStringFunc(layout, func(match string) string {
-		return `(\d+)`
-	})
-
-	re = regexp.MustCompile(layout)
-	matches := re.FindStringSubmatch(value)
-
-	if len(matches) < 7 {
-		return JalaliTime{}, errors.New("unable to parse value using the provided layout")
-	}
-
-	year, _ = strconv.Atoi(matches[1])
-	month, _ = strconv.Atoi(matches[2])
-	day, _ = strconv.Atoi(matches[3])
-	hour, _ = strconv.Atoi(matches[4])
-	min, _ = strconv.Atoi(matches[5])
-	sec, _ = strconv.Atoi(matches[6])
-
-	if !isValidJalaliDate(year, month, day) {
-		return JalaliTime{}, fmt.Errorf("invalid Jalali date: %d/%02d/%02d", year, month, day)
-	}
-
-	return JalaliTime{
-		year:  year,
-		month: Month(month),
-		day:   day,
-		hour:  hour,
-		min:   min,
-		sec:   sec,
-		nsec:  0,
-		loc:   loc,
-	}, nil
-}
-
-func Parse(layout, value string) (JalaliTime, error) {
-	return ParseInLocation(layout, value, time.Local)
-}
-
 // AddDate adds the specified years, months, and days to the JalaliTime object and returns the updated JalaliTime.
 func (j JalaliTime) AddDate(years int, months int, days int) JalaliTime {
 	return j.AddYears(years).AddMonths(months).AddDays(days)
@@ -25,9 +25,11 @@ THE SOFTWARE.
 package jalali
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
 	"reflect"
+	"strings"
 	"testing"
 	"time"
 )
@@ -1915,3 +1917,149 @@ func TestInternalIsLeapJalaliYear(t *testing.T) {
 		}
 	}
 }
+
+func TestAppendFormat(t *testing.T) {
+	j := JalaliTime{1380, 7, 25, 10, 25, 30, 5000, time.FixedZone("IRST", 12600)}
+
+	for _, layout := range []string{"%Y/%m/%d", "%y/%B/%d", "%w%n%R", "%T.%f %p", "%z %Z", "%Od %b %OY", "100%% %q %O", "%"} {
+		want := j.Format(layout)
+		if got := string(j.AppendFormat([]byte("prefix: "), layout)); got != "prefix: "+want {
+			t.Errorf("AppendFormat(%q) = %q, want %q", layout, got, "prefix: "+want)
+		}
+
+		var buf bytes.Buffer
+		n, err := j.WriteTo(&buf, layout)
+		if err != nil || buf.String() != want || n != int64(len(want)) {
+			t.Errorf("WriteTo(%q) = %d, %v, wrote %q, want %q", layout, n, err, buf.String(), want)
+		}
+	}
+
+	if got := j.Format("100%% %q %O%"); got != "100% %q %O%" {
+		t.Errorf("Format() with unknown specifiers = %q", got)
+	}
+	if got := j.Format(strings.Repeat("%Y", 40)); got != strings.Repeat("1380", 40) {
+		t.Errorf("Format() with a long result = %q", got)
+	}
+}
+
+func TestAppendFormatAllocs(t *testing.T) {
+	j := Date(1402, Mordad, 20, 16, 30, 45, 123456789, time.FixedZone("IRST", 12600))
+	buf := make([]byte, 0, 128)
+
+	allocs := testing.AllocsPerRun(100, func() {
+		buf = j.AppendFormat(buf[:0], "%Y-%m-%dT%H:%M:%S.%f%z %Z %w %B %p")
+	})
+	if allocs != 0 {
+		t.Errorf("AppendFormat() allocations = %v, want 0", allocs)
+	}
+}
+
+// benchmarkLayout uses only specifiers that formatLegacy supports, so that
+// BenchmarkFormatLegacy measures the same work as the other benchmarks.
+const benchmarkLayout = "%Y-%m-%d %H:%M:%S %z"
+
+// formatLegacy is Format as it was written before AppendFormat, with
+// fmt.Sprintf for every number, reduced to the numeric specifiers.
+func formatLegacy(j JalaliTime, layout string) string {
+	var builder strings.Builder
+	length := len(layout)
+	i := 0
+
+	for i < length {
+		if layout[i] == '%' && i+1 < length {
+			specifier := layout[i : i+2]
+			switch specifier {
+			case "%%":
+				builder.WriteByte('%')
+			case "%Y":
+				builder.WriteString(fmt.Sprintf("%04d", j.year))
+			case "%m":
+				builder.WriteString(fmt.Sprintf("%02d", j.month))
+			case "%d":
+				builder.WriteString(fmt.Sprintf("%02d", j.day))
+			case "%H":
+				builder.WriteString(fmt.Sprintf("%02d", j.hour))
+			case "%M":
+				builder.WriteString(fmt.Sprintf("%02d", j.min))
+			case "%S":
+				builder.WriteString(fmt.Sprintf("%02d", j.sec))
+			case "%z":
+				_, offset := j.Zone()
+				sign := "+"
+				if offset < 0 {
+					sign = "-"
+					offset = -offset
+				}
+				hours := offset / 3600
+				minutes := (offset % 3600) / 60
+				builder.WriteString(fmt.Sprintf("%s%02d%02d", sign, hours, minutes))
+			default:
+				builder.WriteString(specifier)
+			}
+			i += 2
+		} else {
+			builder.WriteByte(layout[i])
+			i++
+		}
+	}
+
+	return builder.String()
+}
+
+func TestFormatLegacy(t *testing.T) {
+	j := Date(1402, Mordad, 20, 16, 30, 45, 123456789, time.FixedZone("IRST", 12600))
+	if got, want := j.Format(benchmarkLayout), formatLegacy(j, benchmarkLayout); got != want {
+		t.Errorf("Format() = %q, formatLegacy() = %q", got, want)
+	}
+}
+
+func TestWriteToAllocs(t *testing.T) {
+	j := Date(1402, Mordad, 20, 16, 30, 45, 123456789, time.FixedZone("IRST", 12600))
+	var buf bytes.Buffer
+	buf.Grow(128)
+
+	allocs := testing.AllocsPerRun(100, func() {
+		buf.Reset()
+		_, _ = j.WriteTo(&buf, "%Y-%m-%dT%H:%M:%S.%f%z %Z %w %B %p")
+	})
+	if allocs != 0 {
+		t.Errorf("WriteTo() allocations = %v, want 0", allocs)
+	}
+}
+
+func BenchmarkFormatLegacy(b *testing.B) {
+	j := Date(1402, Mordad, 20, 16, 30, 45, 123456789, time.UTC)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_ = formatLegacy(j, benchmarkLayout)
+	}
+}
+
+func BenchmarkFormat(b *testing.B) {
+	j := Date(1402, Mordad, 20, 16, 30, 45, 123456789, time.UTC)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_ = j.Format(benchmarkLayout)
+	}
+}
+
+func BenchmarkAppendFormat(b *testing.B) {
+	j := Date(1402, Mordad, 20, 16, 30, 45, 123456789, time.UTC)
+	buf := make([]byte, 0, 64)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		buf = j.AppendFormat(buf[:0], benchmarkLayout)
+	}
+}
+
+func BenchmarkWriteTo(b *testing.B) {
+	j := Date(1402, Mordad, 20, 16, 30, 45, 123456789, time.UTC)
+	var buf bytes.Buffer
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		buf.Reset()
+		if _, err := j.WriteTo(&buf, benchmarkLayout); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -0,0 +1,123 @@
+/*
+MIT License
+
+Copyright (c) 2023 Mohammad Shafiee <muhammad.shafiee@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+
+package jalali
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// Layouts for machine-readable Jalali dates, following RFC 3339 with Jalali
+// year, month and day fields.
+const (
+	RFC3339     = "%Y-%m-%dT%H:%M:%S%:z"    // 1402-05-20T16:30:45+03:30
+	RFC3339Nano = "%Y-%m-%dT%H:%M:%S.%f%:z" // 1402-05-20T16:30:45.123456789+03:30
+	DateOnly    = "%Y-%m-%d"                // 1402-05-20
+)
+
+// JSONLayout is the layout used by JalaliTime's MarshalJSON and UnmarshalJSON.
+// Set it to RFC3339Nano to keep sub-second precision. It should only be changed
+// during program initialization.
+var JSONLayout = RFC3339
+
+// MarshalJSON implements the json.Marshaler interface. The time is a quoted
+// string formatted with JSONLayout; the zero JalaliTime is null.
+func (j JalaliTime) MarshalJSON() ([]byte, error) {
+	if j.IsZero() {
+		return []byte("null"), nil
+	}
+	return json.Marshal(j.Format(JSONLayout))
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface. The time must be a
+// quoted string in JSONLayout. As with time.Time, the default layout requires
+// a zone offset; only a JSONLayout without %z or %Z reads times in the local
+// time zone. Null is a no-op.
+func (j *JalaliTime) UnmarshalJSON(data []byte) error {
+	return unmarshalJSONString(data, JSONLayout, j)
+}
+
+// JalaliDateJSON is a JalaliTime that is marshalled to JSON as a date without
+// a time of day in the DateOnly layout, such as "1402-05-20".
+type JalaliDateJSON JalaliTime
+
+// MarshalJSON implements the json.Marshaler interface.
+func (d JalaliDateJSON) MarshalJSON() ([]byte, error) {
+	if JalaliTime(d).IsZero() {
+		return []byte("null"), nil
+	}
+	return json.Marshal(JalaliTime(d).Format(DateOnly))
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface. The date is
+// midnight in the local time zone.
+func (d *JalaliDateJSON) UnmarshalJSON(data []byte) error {
+	return unmarshalJSONString(data, DateOnly, (*JalaliTime)(d))
+}
+
+// JalaliUnixJSON is a JalaliTime that is marshalled to JSON as the number of
+// seconds since the Unix epoch, such as 1691758845.
+type JalaliUnixJSON JalaliTime
+
+// MarshalJSON implements the json.Marshaler interface.
+func (u JalaliUnixJSON) MarshalJSON() ([]byte, error) {
+	if JalaliTime(u).IsZero() {
+		return []byte("null"), nil
+	}
+	return strconv.AppendInt(nil, JalaliTime(u).Unix(), 10), nil
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface. The time is in the
+// local time zone.
+func (u *JalaliUnixJSON) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	sec, err := strconv.ParseInt(string(data), 10, 64)
+	if err != nil {
+		return fmt.Errorf("JalaliUnixJSON: expected seconds since the Unix epoch, got %s", data)
+	}
+	*u = JalaliUnixJSON(ToJalali(time.Unix(sec, 0)))
+	return nil
+}
+
+// unmarshalJSONString parses data, a JSON string or null, with layout into j.
+func unmarshalJSONString(data []byte, layout string, j *JalaliTime) error {
+	if string(data) == "null" {
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return fmt.Errorf("JalaliTime: expected a JSON string, got %s", data)
+	}
+	t, err := Parse(layout, s)
+	if err != nil {
+		return err
+	}
+	*j = t
+	return nil
+}
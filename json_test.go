@@ -0,0 +1,138 @@
+/*
+MIT License
+
+Copyright (c) 2023 Mohammad Shafiee <muhammad.shafiee@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+
+package jalali
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestJalaliTimeJSON(t *testing.T) {
+	tehran := time.FixedZone("IRST", 12600)
+	j := Date(1402, Mordad, 20, 16, 30, 45, 123456789, tehran)
+
+	type event struct {
+		At      JalaliTime  `json:"at"`
+		Ends    JalaliTime  `json:"ends"`
+		Updated *JalaliTime `json:"updated,omitempty"`
+	}
+	data, err := json.Marshal(event{At: j})
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+	if want := `{"at":"1402-05-20T16:30:45+03:30","ends":null}`; string(data) != want {
+		t.Errorf("Marshal() = %s, want %s", data, want)
+	}
+
+	var got event
+	if err := json.Unmarshal(data, &got); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+	if !sameInstant(got.At, Date(1402, Mordad, 20, 16, 30, 45, 0, tehran)) || !got.Ends.IsZero() {
+		t.Errorf("Unmarshal() = %+v", got)
+	}
+	if _, offset := got.At.Zone(); offset != 12600 {
+		t.Errorf("Unmarshal() offset = %d, want 12600", offset)
+	}
+
+	if err := json.Unmarshal([]byte(`{"at":"1402-05-20T16:30:45Z"}`), &got); err != nil || got.At.Hour() != 16 {
+		t.Errorf("Unmarshal() with Z = %v, %v", got.At, err)
+	}
+
+	for _, bad := range []string{`{"at":1691760045}`, `{"at":"1402/05/20"}`, `{"at":"1402-12-30T00:00:00Z"}`, `{"at":"1402-05-20T16:30:45"}`} {
+		if err := json.Unmarshal([]byte(bad), &got); err == nil {
+			t.Errorf("Unmarshal(%s) error = nil, want an error", bad)
+		}
+	}
+}
+
+func TestJSONLayout(t *testing.T) {
+	defer func(layout string) { JSONLayout = layout }(JSONLayout)
+	JSONLayout = RFC3339Nano
+
+	j := Date(1402, Mordad, 20, 16, 30, 45, 123456789, time.UTC)
+	data, err := json.Marshal(j)
+	if err != nil || string(data) != `"1402-05-20T16:30:45.123456789+00:00"` {
+		t.Errorf("Marshal() = %s, %v", data, err)
+	}
+	var got JalaliTime
+	if err := json.Unmarshal(data, &got); err != nil || !sameInstant(got, j) {
+		t.Errorf("Unmarshal(%s) = %v, %v, want %v", data, got, err, j)
+	}
+
+	JSONLayout = "%d %B %Y"
+	data, _ = json.Marshal(j)
+	if string(data) != `"20 مرداد 1402"` {
+		t.Errorf("Marshal() with a custom layout = %s", data)
+	}
+}
+
+func TestJalaliDateAndUnixJSON(t *testing.T) {
+	tehran := time.FixedZone("IRST", 12600)
+	j := Date(1402, Mordad, 20, 16, 30, 45, 0, tehran)
+
+	type row struct {
+		Birthday JalaliDateJSON `json:"birthday"`
+		Seen     JalaliUnixJSON `json:"seen"`
+	}
+	data, err := json.Marshal(row{JalaliDateJSON(j), JalaliUnixJSON(j)})
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+	unix := time.Date(2023, time.August, 11, 13, 0, 45, 0, time.UTC).Unix()
+	if want := `{"birthday":"1402-05-20","seen":` + formatInt(unix) + `}`; string(data) != want {
+		t.Errorf("Marshal() = %s, want %s", data, want)
+	}
+
+	var got row
+	if err := json.Unmarshal(data, &got); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+	if b := JalaliTime(got.Birthday); b.Year() != 1402 || b.Month() != Mordad || b.Day() != 20 || b.Hour() != 0 {
+		t.Errorf("Unmarshal() birthday = %v", b)
+	}
+	if !sameInstant(JalaliTime(got.Seen), j) {
+		t.Errorf("Unmarshal() seen = %v, want %v", JalaliTime(got.Seen), j)
+	}
+
+	data, _ = json.Marshal(row{})
+	if string(data) != `{"birthday":null,"seen":null}` {
+		t.Errorf("Marshal() of zero values = %s", data)
+	}
+	if err := json.Unmarshal([]byte(`{"seen":"yesterday"}`), &got); err == nil {
+		t.Error("Unmarshal() of a string as Unix seconds error = nil, want an error")
+	}
+}
+
+// sameInstant reports whether a and b are the same instant, in any location.
+func sameInstant(a, b JalaliTime) bool {
+	return a.ToTime().Equal(b.ToTime())
+}
+
+func formatInt(n int64) string {
+	data, _ := json.Marshal(n)
+	return string(data)
+}
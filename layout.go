@@ -0,0 +1,294 @@
+/*
+MIT License
+
+Copyright (c) 2023 Mohammad Shafiee <muhammad.shafiee@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+
+package jalali
+
+import (
+	"fmt"
+	"time"
+)
+
+// Layout is a precompiled layout for formatting and parsing. A Layout is
+// immutable and safe for concurrent use.
+type Layout struct {
+	layout string
+	elems  []layoutElem
+	locale *Locale
+}
+
+// layoutElem is a piece of a compiled layout: literal text or a specifier.
+type layoutElem struct {
+	text string
+	spec bool
+}
+
+// layoutSpecs contains the specifiers known to Format and Parse.
+var layoutSpecs = map[string]bool{
+	"%n": true, "%%": true, "%Y": true, "%y": true, "%OY": true, "%m": true,
+	"%B": true, "%b": true, "%d": true, "%Od": true, "%H": true, "%I": true, "%M": true,
+	"%S": true, "%f": true, "%p": true, "%w": true, "%z": true, "%:z": true, "%Z": true,
+	"%R": true, "%T": true, "%[": true, "%]": true,
+}
+
+// CompileLayout compiles a layout for repeated use with Format, AppendFormat
+// and Parse, using the names of LocalePersian. Unlike Format, which writes
+// unknown specifiers as they are, it reports unknown specifiers, a trailing %
+// and unbalanced optional section markers as errors.
+func CompileLayout(layout string) (*Layout, error) {
+	c := &Layout{layout: layout, locale: LocalePersian}
+
+	depth := 0
+	for i := 0; i < len(layout); i++ {
+		if layout[i] != '%' {
+			continue
+		}
+		if i == len(layout)-1 {
+			return nil, fmt.Errorf("layout %q: trailing %%", layout)
+		}
+		spec := specifier(layout[i:])
+		if !layoutSpecs[spec] {
+			return nil, fmt.Errorf("layout %q: unknown specifier %q at offset %d", layout, spec, i)
+		}
+		switch spec {
+		case "%[":
+			depth++
+		case "%]":
+			if depth == 0 {
+				return nil, fmt.Errorf("layout %q: %%] without %%[ at offset %d", layout, i)
+			}
+			depth--
+		}
+		i += len(spec) - 1
+	}
+	if depth != 0 {
+		return nil, fmt.Errorf("layout %q: %%[ without %%]", layout)
+	}
+	c.elems = splitLayout(layout)
+	return c, nil
+}
+
+// splitLayout splits layout into literal text and specifiers, expanding %R and
+// %T into their parts so that the parser sees what follows each number. A
+// trailing % is literal text.
+func splitLayout(layout string) []layoutElem {
+	var elems []layoutElem
+	lit := 0 // start of the pending literal text
+	for i := 0; i < len(layout)-1; i++ {
+		if layout[i] != '%' {
+			continue
+		}
+		if lit < i {
+			elems = append(elems, layoutElem{text: layout[lit:i]})
+		}
+		spec := specifier(layout[i:])
+		switch spec {
+		case "%R":
+			elems = append(elems, clockElems[:3]...)
+		case "%T":
+			elems = append(elems, clockElems[:]...)
+		default:
+			elems = append(elems, layoutElem{text: spec, spec: true})
+		}
+		i += len(spec) - 1
+		lit = i + 1
+	}
+	if lit < len(layout) {
+		elems = append(elems, layoutElem{text: layout[lit:]})
+	}
+	return elems
+}
+
+// clockElems are the elements of %T; the first three are those of %R.
+var clockElems = [...]layoutElem{
+	{text: "%H", spec: true}, {text: ":"}, {text: "%M", spec: true}, {text: ":"}, {text: "%S", spec: true},
+}
+
+// MustCompileLayout is like CompileLayout but panics if the layout is invalid.
+// It simplifies the initialization of package variables holding layouts.
+func MustCompileLayout(layout string) *Layout {
+	c, err := CompileLayout(layout)
+	if err != nil {
+		panic(err)
+	}
+	return c
+}
+
+// WithLocale returns a copy of the layout that formats and parses the names of the given locale.
+func (c *Layout) WithLocale(l *Locale) *Layout {
+	copied := *c
+	copied.locale = l
+	return &copied
+}
+
+// String returns the source text of the layout.
+func (c *Layout) String() string {
+	return c.layout
+}
+
+// Format returns j formatted according to the layout.
+func (c *Layout) Format(j JalaliTime) string {
+	const bufSize = 64
+	var buf [bufSize]byte
+	return string(c.AppendFormat(buf[:0], j))
+}
+
+// AppendFormat is like Format but appends the text to b and returns the
+// extended buffer. It does not allocate unless b is too small, or the layout
+// writes numbers in words with %Od or %OY.
+func (c *Layout) AppendFormat(b []byte, j JalaliTime) []byte {
+	for _, e := range c.elems {
+		if e.spec {
+			b = appendSpec(b, j, e.text, c.locale)
+		} else {
+			b = append(b, e.text...)
+		}
+	}
+	return b
+}
+
+// Parse parses value according to the layout in the given location, without
+// scanning the layout again. See ParseInLocation, and ParseOptions.ParseLayout
+// for strict and lenient parsing with a compiled layout.
+func (c *Layout) Parse(value string, loc *time.Location) (JalaliTime, error) {
+	p := &parser{layout: c.layout, elems: c.elems, value: value, locale: c.locale}
+	return p.run(loc)
+}
+
+// specifier returns the specifier at the start of layout, which starts with %
+// and is followed by at least one byte.
+func specifier(layout string) string {
+	if len(layout) > 2 {
+		switch layout[:3] {
+		case "%Od", "%OY", "%:z":
+			return layout[:3]
+		}
+	}
+	return layout[:2]
+}
+
+// appendSpec appends j formatted according to the specifier spec to b.
+func appendSpec(b []byte, j JalaliTime, spec string, l *Locale) []byte {
+	switch spec {
+	case "%n":
+		return append(b, '\n')
+	case "%%":
+		return append(b, '%')
+	case "%Y":
+		return appendInt(b, l.Era.Year(j.year), 4)
+	case "%y":
+		return appendInt(b, l.Era.Year(j.year)%100, 2)
+	case "%OY":
+		return append(b, PersianWords(int64(l.Era.Year(j.year)))...)
+	case "%m":
+		return appendInt(b, int(j.month), 2)
+	case "%B":
+		return append(b, j.month.Name(l)...)
+	case "%b":
+		return append(b, j.month.ShortName(l)...)
+	case "%d":
+		return appendInt(b, j.day, 2)
+	case "%Od":
+		return append(b, PersianOrdinalWords(int64(j.day))...)
+	case "%H":
+		return appendInt(b, j.hour, 2)
+	case "%I":
+		hour := j.hour % 12
+		if hour == 0 {
+			hour = 12
+		}
+		return appendInt(b, hour, 2)
+	case "%M":
+		return appendInt(b, j.min, 2)
+	case "%S":
+		return appendInt(b, j.sec, 2)
+	case "%f":
+		return appendInt(b, j.nsec, 9)
+	case "%R":
+		b = appendInt(b, j.hour, 2)
+		b = append(b, ':')
+		return appendInt(b, j.min, 2)
+	case "%T":
+		b = appendInt(b, j.hour, 2)
+		b = append(b, ':')
+		b = appendInt(b, j.min, 2)
+		b = append(b, ':')
+		return appendInt(b, j.sec, 2)
+	case "%p":
+		if j.hour < 12 {
+			return append(b, l.AM...)
+		}
+		return append(b, l.PM...)
+	case "%w":
+		return append(b, j.Weekday().Name(l)...)
+	case "%z", "%:z":
+		_, offset := j.Zone()
+		if offset < 0 {
+			b = append(b, '-')
+			offset = -offset
+		} else {
+			b = append(b, '+')
+		}
+		b = appendInt(b, offset/3600, 2)
+		if spec == "%:z" {
+			b = append(b, ':')
+		}
+		return appendInt(b, offset%3600/60, 2)
+	case "%Z":
+		if j.loc != nil {
+			b = append(b, j.loc.String()...)
+		}
+		return b
+	case "%[", "%]":
+		// Optional section markers are only meaningful when parsing.
+		return b
+	}
+	// Unknown specifiers are written as they are.
+	return append(b, spec...)
+}
+
+// appendInt appends n in decimal to b, padded with zeros to width characters
+// including the sign, as fmt does for %0*d.
+func appendInt(b []byte, n, width int) []byte {
+	u := uint64(n)
+	if n < 0 {
+		b = append(b, '-')
+		u = uint64(-n)
+		width--
+	}
+
+	var buf [20]byte
+	i := len(buf)
+	for u >= 10 {
+		i--
+		buf[i] = byte('0' + u%10)
+		u /= 10
+	}
+	i--
+	buf[i] = byte('0' + u)
+
+	for w := len(buf) - i; w < width; w++ {
+		b = append(b, '0')
+	}
+	return append(b, buf[i:]...)
+}
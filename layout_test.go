@@ -0,0 +1,235 @@
+/*
+MIT License
+
+Copyright (c) 2023 Mohammad Shafiee <muhammad.shafiee@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+
+package jalali
+
+import (
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestCompileLayoutFormat(t *testing.T) {
+	loc := time.FixedZone("IRST", 12600)
+	times := []JalaliTime{
+		Date(1402, Mordad, 20, 16, 30, 45, 123456789, loc),
+		Date(1, Farvardin, 1, 0, 0, 0, 0, time.UTC),
+		Date(1403, Esfand, 30, 23, 59, 59, 999999999, time.FixedZone("", -5400)),
+	}
+	layouts := []string{
+		"%Y/%m/%d %H:%M:%S",
+		"%y-%b-%d %R %p %%",
+		"%w %d %B %Y%n%T.%f %z %:z %Z",
+		"%Od %B %OY",
+		"%Y/%m/%d%[ %H:%M%]",
+		"",
+		"literal only",
+	}
+
+	for _, layout := range layouts {
+		c, err := CompileLayout(layout)
+		if err != nil {
+			t.Fatalf("CompileLayout(%q) error = %v", layout, err)
+		}
+		for _, l := range []*Locale{LocalePersian, LocaleEnglish, LocaleSorani} {
+			for _, j := range times {
+				want := j.FormatLocale(layout, l)
+				if got := c.WithLocale(l).Format(j); got != want {
+					t.Errorf("Layout(%q).Format(%v) in %s = %q, want %q", layout, j, l.Tag, got, want)
+				}
+				if got := string(c.WithLocale(l).AppendFormat([]byte("x"), j)); got != "x"+want {
+					t.Errorf("Layout(%q).AppendFormat(%v) in %s = %q, want %q", layout, j, l.Tag, got, "x"+want)
+				}
+			}
+		}
+	}
+}
+
+func TestCompileLayoutErrors(t *testing.T) {
+	tests := []struct {
+		layout, err string
+	}{
+		{"%Y/%m/%q", `unknown specifier "%q" at offset 6`},
+		{"%Y/%m/%d%", "trailing %"},
+		{"%Y%]", "%] without %[ at offset 2"},
+		{"%Y%[ %H", "%[ without %]"},
+		{"%Ox", `unknown specifier "%O"`},
+	}
+
+	for _, tc := range tests {
+		_, err := CompileLayout(tc.layout)
+		if err == nil || !strings.Contains(err.Error(), tc.err) {
+			t.Errorf("CompileLayout(%q) error = %v, want %q", tc.layout, err, tc.err)
+		}
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("MustCompileLayout() did not panic")
+		}
+	}()
+	MustCompileLayout("%q")
+}
+
+func TestLayoutParse(t *testing.T) {
+	c := MustCompileLayout("%Y/%m/%d %T%[ %z%]")
+	want := Date(1402, Mordad, 20, 16, 30, 45, 0, time.UTC)
+
+	got, err := c.Parse("1402/05/20 16:30:45", time.UTC)
+	if err != nil || got != want {
+		t.Errorf("Parse() = %v, %v, want %v", got, err, want)
+	}
+	got, err = c.Parse(c.Format(want), time.UTC)
+	if err != nil || got != want {
+		t.Errorf("Parse(Format()) = %v, %v, want %v", got, err, want)
+	}
+	if _, err := c.Parse("1402/05/20", time.UTC); err == nil {
+		t.Error("Parse() with a missing time error = nil, want an error")
+	}
+
+	english := MustCompileLayout("%d %B %Y").WithLocale(LocaleEnglish)
+	if got, err := english.Parse("20 Mordad 1402", time.UTC); err != nil || got.Month() != Mordad {
+		t.Errorf("Parse() in English = %v, %v", got, err)
+	}
+	if c.String() != "%Y/%m/%d %T%[ %z%]" {
+		t.Errorf("String() = %q", c.String())
+	}
+}
+
+func TestLayoutParseMatchesParseInLocale(t *testing.T) {
+	layouts := []string{
+		"%Y/%m/%d %T.%f",
+		"%Y%m%d%H%M%S",
+		"%d %B %Y%[ %R%[:%S%]%]",
+		"%Y-%m-%dT%T%:z",
+		"%Y/%m/%d 100%%",
+	}
+	j := Date(1402, Mordad, 20, 16, 30, 45, 250000000, time.FixedZone("IRST", 12600))
+	for _, layout := range layouts {
+		c := MustCompileLayout(layout)
+		value := j.Format(layout)
+		want, wantErr := ParseInLocale(layout, value, time.UTC, LocalePersian)
+		got, err := c.Parse(value, time.UTC)
+		if !got.Equal(want) || (err == nil) != (wantErr == nil) {
+			t.Errorf("%q: Layout.Parse(%q) = %v, %v, ParseInLocale = %v, %v", layout, value, got, err, want, wantErr)
+		}
+	}
+}
+
+func TestParseOptionsParseLayout(t *testing.T) {
+	c := MustCompileLayout("%Y/%m/%d %H:%M")
+	want := Date(1402, Mordad, 5, 16, 30, 0, 0, time.UTC)
+
+	if got, err := (ParseOptions{Mode: ModeLenient}).ParseLayout(c, " 1402 / 5 / 5  16:30 ", time.UTC); err != nil || got != want {
+		t.Errorf("lenient ParseLayout() = %v, %v, want %v", got, err, want)
+	}
+	if _, err := (ParseOptions{Mode: ModeStrict}).ParseLayout(c, "1402/5/05 16:30", time.UTC); err == nil {
+		t.Error("strict ParseLayout() accepted a one-digit month")
+	}
+
+	// The layout's locale is used unless the options name one.
+	english := MustCompileLayout("%d %B %Y").WithLocale(LocaleEnglish)
+	if got, err := (ParseOptions{}).ParseLayout(english, "5 Mordad 1402", time.UTC); err != nil || got.Month() != Mordad {
+		t.Errorf("ParseLayout() = %v, %v", got, err)
+	}
+	if got, err := (ParseOptions{Locale: LocaleKurmanji}).ParseLayout(english, "5 Gelawêj 2723", time.UTC); err != nil || got.Year() != 1402 {
+		t.Errorf("ParseLayout() with the Kurmanji locale = %v, %v, want the year 1402", got, err)
+	}
+}
+
+func TestLayoutAppendFormatAllocs(t *testing.T) {
+	c := MustCompileLayout("%Y-%m-%dT%H:%M:%S.%f%z %Z %w %B %p")
+	j := Date(1402, Mordad, 20, 16, 30, 45, 123456789, time.FixedZone("IRST", 12600))
+	buf := make([]byte, 0, 128)
+
+	allocs := testing.AllocsPerRun(100, func() {
+		buf = c.AppendFormat(buf[:0], j)
+	})
+	if allocs != 0 {
+		t.Errorf("AppendFormat() allocations = %v, want 0", allocs)
+	}
+}
+
+func TestLayoutConcurrent(t *testing.T) {
+	c := MustCompileLayout("%Y/%m/%d %T")
+	j := Date(1402, Mordad, 20, 16, 30, 45, 0, time.UTC)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for k := 0; k < 100; k++ {
+				if got := c.Format(j); got != "1402/05/20 16:30:45" {
+					t.Errorf("Format() = %q", got)
+					return
+				}
+				if _, err := c.Parse("1402/05/20 16:30:45", time.UTC); err != nil {
+					t.Errorf("Parse() error = %v", err)
+					return
+				}
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+func BenchmarkLayoutAppendFormat(b *testing.B) {
+	c := MustCompileLayout("%Y-%m-%d %H:%M:%S.%f %z")
+	j := Date(1402, Mordad, 20, 16, 30, 45, 123456789, time.UTC)
+	buf := make([]byte, 0, 64)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		buf = c.AppendFormat(buf[:0], j)
+	}
+}
+
+func BenchmarkLayoutFormat(b *testing.B) {
+	c := MustCompileLayout("%Y-%m-%d %H:%M:%S.%f %z")
+	j := Date(1402, Mordad, 20, 16, 30, 45, 123456789, time.UTC)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_ = c.Format(j)
+	}
+}
+
+func BenchmarkLayoutParse(b *testing.B) {
+	c := MustCompileLayout("%Y-%m-%d %H:%M:%S")
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if _, err := c.Parse("1402-05-20 16:30:45", time.UTC); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkParseInLocation(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if _, err := ParseInLocation("%Y-%m-%d %H:%M:%S", "1402-05-20 16:30:45", time.UTC); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -0,0 +1,269 @@
+/*
+MIT License
+
+Copyright (c) 2023 Mohammad Shafiee <muhammad.shafiee@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+
+package jalali
+
+import (
+	"fmt"
+	"unicode/utf8"
+)
+
+// AfghanMonthName contains the Latin transliteration of the zodiac month names used in Afghanistan.
+var AfghanMonthName = []string{
+	"",
+	"Hamal", "Sawr", "Jawza",
+	"Saratan", "Asad", "Sunbula",
+	"Mizan", "Aqrab", "Qaws",
+	"Jadi", "Dalw", "Hoot",
+}
+
+// DariMonthName contains the names of the months of the Afghan solar Hijri calendar in Dari.
+var DariMonthName = []string{
+	"",
+	"حمل", "ثور", "جوزا",
+	"سرطان", "اسد", "سنبله",
+	"میزان", "عقرب", "قوس",
+	"جدی", "دلو", "حوت",
+}
+
+// PashtoMonthName contains the names of the months of the Afghan solar Hijri calendar in Pashto.
+var PashtoMonthName = []string{
+	"",
+	"وری", "غویی", "غبرگولی",
+	"چنگاښ", "زمری", "وږی",
+	"تله", "لړم", "لیندۍ",
+	"مرغومی", "سلواغه", "کب",
+}
+
+// DariWeekDays contains the names of the weekdays in Dari.
+var DariWeekDays = []string{"یکشنبه", "دوشنبه", "سه‌شنبه", "چهارشنبه", "پنجشنبه", "جمعه", "شنبه"}
+
+// SoraniMonthName contains the names of the months of the Kurdish solar calendar in Sorani.
+var SoraniMonthName = []string{
+	"",
+	"خاکەلێوە", "گوڵان", "جۆزەردان",
+	"پووشپەڕ", "گەلاوێژ", "خەرمانان",
+	"ڕەزبەر", "گەڵاڕێزان", "سەرماوەز",
+	"بەفرانبار", "ڕێبەندان", "ڕەشەمە",
+}
+
+// KurmanjiMonthName contains the names of the months of the Kurdish solar calendar in the Latin alphabet.
+var KurmanjiMonthName = []string{
+	"",
+	"Xakelêwe", "Gullan", "Cozerdan",
+	"Pûşper", "Gelawêj", "Xermanan",
+	"Rezber", "Gelarêzan", "Sermawez",
+	"Befranbar", "Rêbendan", "Reşeme",
+}
+
+// KurmanjiMonthAbbr contains the abbreviated month names in Kurmanji. Gelawêj
+// and Gelarêzan share their first three letters, so they take four.
+var KurmanjiMonthAbbr = []string{
+	"",
+	"Xak", "Gul", "Coz",
+	"Pûş", "Gelw", "Xer",
+	"Rez", "Gelr", "Ser",
+	"Bef", "Rêb", "Reş",
+}
+
+// SoraniWeekDays contains the names of the weekdays in Sorani.
+var SoraniWeekDays = []string{"یەکشەممە", "دووشەممە", "سێشەممە", "چوارشەممە", "پێنجشەممە", "هەینی", "شەممە"}
+
+// KurmanjiWeekDays contains the names of the weekdays in Kurmanji.
+var KurmanjiWeekDays = []string{"Yekşem", "Duşem", "Sêşem", "Çarşem", "Pêncşem", "În", "Şemî"}
+
+// PashtoWeekDays contains the names of the weekdays in Pashto.
+var PashtoWeekDays = []string{"یونۍ", "دونۍ", "درېنۍ", "څلرنۍ", "پينځنۍ", "جمعه", "اونۍ"}
+
+// EnGregorianWeekDays contains the English names of the weekdays, indexed by Weekday.
+var EnGregorianWeekDays = []string{"Sunday", "Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday"}
+
+// Locale describes the month names, weekday names and other strings used when
+// formatting and parsing Jalali dates for a particular language or region.
+// The predefined locales must not be modified; use WithWeekend or a copy instead.
+type Locale struct {
+	// Tag is the BCP 47 language tag of the locale, e.g. "fa-IR" or "ps-AF".
+	Tag string
+
+	// MonthNames contains the month names, indexed by Month (index 0 is unused).
+	MonthNames []string
+
+	// MonthAbbrs contains the abbreviated month names, indexed by Month.
+	// If nil, the first three characters of each month name are used.
+	MonthAbbrs []string
+
+	// WeekdayNames contains the weekday names, indexed by Weekday.
+	WeekdayNames []string
+
+	// AM and PM are the markers written by the %p specifier.
+	AM, PM string
+
+	// Weekend lists the weekdays that are not working days.
+	Weekend []Weekday
+
+	// Era is the year numbering written by %Y and %y and accepted by Parse.
+	// The zero value uses Jalali years.
+	Era Era
+}
+
+// LocalePersian is the default locale used by Format and Parse: Persian names as used in Iran.
+var LocalePersian = &Locale{
+	Tag:          "fa-IR",
+	MonthNames:   FaJalaliMonthName,
+	WeekdayNames: FaWeekDays,
+	AM:           "صبح",
+	PM:           "عصر",
+	Weekend:      []Weekday{Joomeh},
+}
+
+// LocaleEnglish uses the English transliteration of the Persian month and weekday names.
+var LocaleEnglish = &Locale{
+	Tag:          "en",
+	MonthNames:   EnJalaliMonthName,
+	WeekdayNames: EnWeekDays,
+	AM:           "AM",
+	PM:           "PM",
+	Weekend:      []Weekday{Joomeh},
+}
+
+// LocaleDari uses the Afghan zodiac month names in Dari. The weekend is Thursday and Friday.
+var LocaleDari = &Locale{
+	Tag:          "fa-AF",
+	MonthNames:   DariMonthName,
+	WeekdayNames: DariWeekDays,
+	AM:           "ق.ظ",
+	PM:           "ب.ظ",
+	Weekend:      []Weekday{Panjshanbe, Joomeh},
+}
+
+// LocalePashto uses the Afghan zodiac month names in Pashto. The weekend is Thursday and Friday.
+var LocalePashto = &Locale{
+	Tag:          "ps-AF",
+	MonthNames:   PashtoMonthName,
+	WeekdayNames: PashtoWeekDays,
+	AM:           "غ.م",
+	PM:           "غ.و",
+	Weekend:      []Weekday{Panjshanbe, Joomeh},
+}
+
+// LocaleAfghanEnglish uses the Latin transliteration of the Afghan zodiac month names
+// (Hamal, Sawr, Jawza, ...). The weekend is Thursday and Friday.
+var LocaleAfghanEnglish = &Locale{
+	Tag:          "en-AF",
+	MonthNames:   AfghanMonthName,
+	WeekdayNames: EnGregorianWeekDays,
+	AM:           "AM",
+	PM:           "PM",
+	Weekend:      []Weekday{Panjshanbe, Joomeh},
+}
+
+// LocaleSorani uses the Kurdish month names in Sorani and Kurdish year numbering.
+var LocaleSorani = &Locale{
+	Tag:          "ckb",
+	MonthNames:   SoraniMonthName,
+	WeekdayNames: SoraniWeekDays,
+	AM:           "پ.ن",
+	PM:           "د.ن",
+	Weekend:      []Weekday{Joomeh},
+	Era:          EraKurdish,
+}
+
+// LocaleKurmanji uses the Kurdish month names in the Latin alphabet and Kurdish year numbering.
+var LocaleKurmanji = &Locale{
+	Tag:          "kmr",
+	MonthNames:   KurmanjiMonthName,
+	MonthAbbrs:   KurmanjiMonthAbbr,
+	WeekdayNames: KurmanjiWeekDays,
+	AM:           "BN",
+	PM:           "PN",
+	Weekend:      []Weekday{Joomeh},
+	Era:          EraKurdish,
+}
+
+// Name returns the name of the month in the given locale.
+func (m Month) Name(l *Locale) string {
+	if int(m) < 1 || int(m) > len(l.MonthNames)-1 {
+		panic(fmt.Sprintf("invalid month value: %v", int(m)))
+	}
+	return l.MonthNames[m]
+}
+
+// ShortName returns the abbreviated name of the month in the given locale.
+func (m Month) ShortName(l *Locale) string {
+	if l.MonthAbbrs != nil {
+		if int(m) < 1 || int(m) > len(l.MonthAbbrs)-1 {
+			panic(fmt.Sprintf("invalid month value: %v", int(m)))
+		}
+		return l.MonthAbbrs[m]
+	}
+	return abbreviate(m.Name(l))
+}
+
+// Name returns the name of the weekday in the given locale.
+func (w Weekday) Name(l *Locale) string {
+	if int(w) < 0 || int(w) > len(l.WeekdayNames)-1 {
+		panic(fmt.Sprintf("invalid weekday value: %v", int(w)))
+	}
+	return l.WeekdayNames[w]
+}
+
+// IsWeekend reports whether w is a weekend day in the locale.
+func (l *Locale) IsWeekend(w Weekday) bool {
+	for _, d := range l.Weekend {
+		if d == w {
+			return true
+		}
+	}
+	return false
+}
+
+// WithWeekend returns a copy of the locale with the weekend set to the given days.
+func (l *Locale) WithWeekend(days ...Weekday) *Locale {
+	c := *l
+	c.Weekend = append([]Weekday(nil), days...)
+	return &c
+}
+
+// WithEra returns a copy of the locale that numbers years in the given era.
+func (l *Locale) WithEra(e Era) *Locale {
+	c := *l
+	c.Era = e
+	return &c
+}
+
+// IsWeekend reports whether the date of j falls on a weekend day in the given locale.
+func (j JalaliTime) IsWeekend(l *Locale) bool {
+	return l.IsWeekend(j.Weekday())
+}
+
+// abbreviate returns the first three characters of name.
+func abbreviate(name string) string {
+	i, n := 0, 0
+	for i < len(name) && n < 3 {
+		_, size := utf8.DecodeRuneInString(name[i:])
+		i += size
+		n++
+	}
+	return name[:i]
+}
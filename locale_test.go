@@ -0,0 +1,138 @@
+/*
+MIT License
+
+Copyright (c) 2023 Mohammad Shafiee <muhammad.shafiee@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+
+package jalali
+
+import (
+	"testing"
+	"time"
+)
+
+func TestMonthName(t *testing.T) {
+	testCases := []struct {
+		month  Month
+		locale *Locale
+		want   string
+		short  string
+	}{
+		{Farvardin, LocaleDari, "حمل", "حمل"},
+		{Ordibehesht, LocaleAfghanEnglish, "Sawr", "Saw"},
+		{Khordad, LocalePashto, "غبرگولی", "غبر"},
+		{Esfand, LocaleAfghanEnglish, "Hoot", "Hoo"},
+		{Mehr, LocalePersian, "مهر", "مهر"},
+		{Ordibehesht, LocalePersian, "اردیبهشت", "ارد"},
+	}
+
+	for _, tc := range testCases {
+		if got := tc.month.Name(tc.locale); got != tc.want {
+			t.Errorf("Month(%d).Name(%s) = %v, want %v", tc.month, tc.locale.Tag, got, tc.want)
+		}
+		if got := tc.month.ShortName(tc.locale); got != tc.short {
+			t.Errorf("Month(%d).ShortName(%s) = %v, want %v", tc.month, tc.locale.Tag, got, tc.short)
+		}
+	}
+}
+
+func TestWeekdayName(t *testing.T) {
+	if got := Joomeh.Name(LocalePashto); got != "جمعه" {
+		t.Errorf("Joomeh.Name(ps-AF) = %v, want %v", got, "جمعه")
+	}
+	if got := Shanbe.Name(LocaleAfghanEnglish); got != "Saturday" {
+		t.Errorf("Shanbe.Name(en-AF) = %v, want %v", got, "Saturday")
+	}
+}
+
+func TestLocaleWeekend(t *testing.T) {
+	if !LocaleDari.IsWeekend(Panjshanbe) || !LocaleDari.IsWeekend(Joomeh) || LocaleDari.IsWeekend(Shanbe) {
+		t.Errorf("unexpected Dari weekend %v", LocaleDari.Weekend)
+	}
+	if LocalePersian.IsWeekend(Panjshanbe) {
+		t.Errorf("Thursday should not be a weekend day in %s", LocalePersian.Tag)
+	}
+
+	custom := LocaleDari.WithWeekend(Joomeh, Shanbe)
+	if !custom.IsWeekend(Shanbe) || custom.IsWeekend(Panjshanbe) {
+		t.Errorf("WithWeekend() weekend = %v", custom.Weekend)
+	}
+	if LocaleDari.IsWeekend(Shanbe) {
+		t.Errorf("WithWeekend() modified the original locale")
+	}
+
+	// 1402/05/20 is a Friday.
+	j := Date(1402, Mordad, 20, 0, 0, 0, 0, time.UTC)
+	if !j.IsWeekend(LocalePashto) {
+		t.Errorf("%v should be a weekend day in %s", j, LocalePashto.Tag)
+	}
+}
+
+func TestFormatLocale(t *testing.T) {
+	j := Date(1402, Mordad, 20, 16, 30, 45, 0, time.UTC)
+
+	tests := []struct {
+		locale   *Locale
+		layout   string
+		expected string
+	}{
+		{LocaleDari, "%d %B %Y", "20 اسد 1402"},
+		{LocalePashto, "%d %B %Y %w", "20 زمری 1402 جمعه"},
+		{LocaleAfghanEnglish, "%d %b %Y %p", "20 Asa 1402 PM"},
+		{LocaleEnglish, "%B %d, %Y", "Mordad 20, 1402"},
+		{LocalePersian, "%d %B %Y", "20 مرداد 1402"},
+	}
+
+	for _, tc := range tests {
+		if got := j.FormatLocale(tc.layout, tc.locale); got != tc.expected {
+			t.Errorf("FormatLocale(%q, %s) = %v, want %v", tc.layout, tc.locale.Tag, got, tc.expected)
+		}
+	}
+}
+
+func TestParseInLocale(t *testing.T) {
+	want := Date(1402, Mordad, 20, 16, 30, 45, 0, time.UTC)
+
+	tests := []struct {
+		locale *Locale
+		layout string
+		value  string
+	}{
+		{LocaleDari, "%d %B %Y %H:%M:%S", "20 اسد 1402 16:30:45"},
+		{LocalePashto, "%d %B %Y %H:%M:%S", "20 زمری 1402 16:30:45"},
+		{LocaleAfghanEnglish, "%B %d, %Y %H:%M:%S", "Asad 20, 1402 16:30:45"},
+	}
+
+	for _, tc := range tests {
+		got, err := ParseInLocale(tc.layout, tc.value, time.UTC, tc.locale)
+		if err != nil {
+			t.Errorf("ParseInLocale(%q, %q) error = %v", tc.layout, tc.value, err)
+			continue
+		}
+		if got != want {
+			t.Errorf("ParseInLocale(%q, %q) = %v, want %v", tc.layout, tc.value, got, want)
+		}
+	}
+
+	if _, err := ParseInLocale("%d %B %Y", "20 Saratan 1402", time.UTC, LocaleDari); err == nil {
+		t.Errorf("ParseInLocale() accepted an unknown month name")
+	}
+}
@@ -0,0 +1,893 @@
+/*
+MIT License
+
+Copyright (c) 2023 Mohammad Shafiee <muhammad.shafiee@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+
+package jalali
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+)
+
+// ErrInvalidDate is returned, wrapped, when a parsed value names a day that does
+// not exist in the Jalali calendar, such as Esfand 30 in a common year.
+var ErrInvalidDate = errors.New("invalid Jalali date")
+
+// errParse is returned by the parsing helpers when the value does not match.
+var errParse = errors.New("unable to parse value using the provided layout")
+
+// ParseError describes a problem parsing a Jalali time string.
+type ParseError struct {
+	Layout     string // the layout passed to the parse function
+	Value      string // the value passed to the parse function
+	LayoutElem string // the layout element that did not match; empty for extra text
+	ValueElem  string // the rest of the value, starting where the match failed
+	Offset     int    // byte offset of ValueElem in Value
+	Reason     string // why the element did not match
+}
+
+// Error returns the string representation of a ParseError.
+func (e *ParseError) Error() string {
+	prefix := fmt.Sprintf("parsing time %q as %q: ", e.Value, e.Layout)
+	if e.LayoutElem == "" {
+		return prefix + e.Reason + " " + fmt.Sprintf("%q", e.ValueElem)
+	}
+	return prefix + fmt.Sprintf("cannot parse %q as %q: %s", e.ValueElem, e.LayoutElem, e.Reason)
+}
+
+// parser holds the state of a single parse of a value against a layout.
+type parser struct {
+	layout string       // the complete layout, for errors
+	elems  []layoutElem // the layout split by splitLayout
+	value  string       // the complete value, for errors
+	locale *Locale
+
+	// gregorian reads the date fields as a Gregorian date, which time converts.
+	gregorian bool
+	mode      ParseMode
+	pivot     int // two-digit year pivot, or 0 for DefaultTwoDigitYearPivot
+
+	year, month, day int
+	hour, min, sec   int
+	nsec             int
+	hasYear          bool
+	hasMonth         bool
+	hasDay           bool
+	pm               int // 0 if no %p was parsed, 1 for AM and 2 for PM
+
+	offset    int    // zone offset in seconds east of UTC read by %z
+	hasOffset bool   // whether %z was read
+	zoneName  string // zone name read by %Z
+}
+
+// ParseInLocation parses a value formatted according to layout and returns the
+// JalaliTime it represents in the given location. The layout uses the same
+// specifiers as Format; every specifier Format writes can be parsed, in any order.
+//
+// Numbers may be written with or without zero padding, in ASCII, Persian (۰-۹)
+// or Arabic-Indic (٠-٩) digits. Month and weekday names are matched in the
+// locale, in Persian, in English and in common Finglish spellings such as
+// "Amordad" or "Ordibehest", ignoring case, the Arabic letters ي and ك, and
+// whether a zero-width non-joiner or a space separates the parts of a name. Fields missing from the
+// layout take their earliest value: year 1, Farvardin, day 1 and midnight. A
+// section of the layout enclosed in %[ and %] is optional: it is skipped when
+// the value does not match it, e.g. "%Y/%m/%d%[ %H:%M%]". The weekday (%w) is
+// checked for its form but otherwise ignored.
+//
+// Fractional seconds of up to nanosecond precision are read by %f, and also
+// directly after %S when the layout does not itself continue with a decimal
+// separator. A time zone offset read by %z or %:z (±hhmm, ±hh:mm or Z) or a zone
+// read by %Z (an IANA name such as Asia/Tehran or an abbreviation such as IRST)
+// overrides loc, as in time.Parse: the result is in loc if loc has the same
+// offset at that time, in the named zone if there is one, and otherwise in a
+// fixed zone with the parsed offset. A nil loc means the local time zone.
+//
+// Two-digit years read by %y fall between 1380 and 1479; see
+// DefaultTwoDigitYearPivot. ParseOptions changes the pivot and offers strict
+// and lenient parsing.
+//
+// If the value does not match the layout, the error is a *ParseError. If the
+// value matches but names a day that does not exist, the error wraps ErrInvalidDate.
+func ParseInLocation(layout, value string, loc *time.Location) (JalaliTime, error) {
+	return ParseInLocale(layout, value, loc, LocalePersian)
+}
+
+// ParseInLocale is like ParseInLocation, but matches the names of the given
+// locale and reads years in the locale's era.
+func ParseInLocale(layout, value string, loc *time.Location, l *Locale) (JalaliTime, error) {
+	return parseLayout(layout, value, loc, l, false)
+}
+
+// parseLayout parses value according to layout, reading the date fields as a
+// Gregorian date if gregorian is set.
+func parseLayout(layout, value string, loc *time.Location, l *Locale, gregorian bool) (JalaliTime, error) {
+	p := &parser{layout: layout, elems: splitLayout(layout), value: value, locale: l, gregorian: gregorian}
+	return p.run(loc)
+}
+
+// run parses p.value according to p.elems and returns the time it represents.
+func (p *parser) run(loc *time.Location) (JalaliTime, error) {
+	if loc == nil {
+		loc = time.Local
+	}
+	rest, err := p.parse(p.elems, p.value)
+	if err != nil {
+		return JalaliTime{}, err
+	}
+	if p.mode == ModeLenient {
+		rest = strings.TrimLeftFunc(rest, unicode.IsSpace)
+	}
+	if rest != "" {
+		return JalaliTime{}, p.error("", rest, "extra text")
+	}
+
+	return p.time(loc)
+}
+
+// Parse parses a Jalali time in the local time zone. See ParseInLocation.
+func Parse(layout, value string) (JalaliTime, error) {
+	return ParseInLocation(layout, value, time.Local)
+}
+
+// parse matches value against the layout elements elems, storing the fields
+// it reads, and returns the part of value that follows the match.
+func (p *parser) parse(elems []layoutElem, value string) (string, error) {
+	for i := 0; i < len(elems); i++ {
+		e := elems[i]
+		if !e.spec {
+			rest, ok := p.matchLiteral(value, e.text)
+			if !ok {
+				return value, p.error(e.text, value, "text does not match the layout")
+			}
+			value = rest
+			continue
+		}
+
+		// In lenient mode, white space is skipped before every specifier.
+		if p.mode == ModeLenient {
+			value = strings.TrimLeftFunc(value, unicode.IsSpace)
+		}
+
+		spec := e.text
+		next := elems[i+1:]
+		start := value
+		var err error
+		switch spec {
+		case "%[":
+			end := closeOptional(elems, i+1)
+			saved := *p
+			if rest, err := p.parse(elems[i+1:end], value); err == nil {
+				value = rest
+			} else {
+				*p = saved
+			}
+			i = end
+		case "%]":
+			// A stray closing marker matches nothing.
+		case "%n":
+			if p.mode == ModeLenient {
+				value = strings.TrimLeftFunc(value, unicode.IsSpace)
+				break
+			}
+			value, err = p.literal(value, "\n")
+		case "%%":
+			value, err = p.literal(value, "%")
+		case "%Y":
+			// Years are only limited to four digits when another number follows directly.
+			maxWidth := 9
+			if numberFollows(next) || p.mode == ModeStrict {
+				maxWidth = 4
+			}
+			p.year, value, err = p.getNum(value, maxWidth)
+			p.year = p.locale.Era.JalaliYear(p.year)
+			p.hasYear = true
+		case "%y":
+			p.year, value, err = p.getNum(value, 2)
+			p.year = p.twoDigitYear(p.year)
+			p.hasYear = true
+		case "%OY":
+			p.year, value, err = getPersianWords(value)
+			p.year = p.locale.Era.JalaliYear(p.year)
+			p.hasYear = true
+		case "%m":
+			p.month, value, err = p.getNum(value, 2)
+			p.hasMonth = true
+		case "%B", "%b":
+			p.month, value, err = lookupName(value, p.monthNames())
+			p.hasMonth = true
+		case "%d":
+			p.day, value, err = p.getNum(value, 2)
+			p.hasDay = true
+		case "%Od":
+			p.day, value, err = lookupName(value, persianOrdinalDays)
+			p.hasDay = true
+		case "%H":
+			p.hour, value, err = p.getNum(value, 2)
+			if err == nil && p.hour > 23 {
+				return start, p.error(spec, start, "hour out of range")
+			}
+		case "%I":
+			p.hour, value, err = p.getNum(value, 2)
+			if err == nil && (p.hour < 1 || p.hour > 12) {
+				return start, p.error(spec, start, "hour out of range")
+			}
+		case "%M":
+			p.min, value, err = p.getNum(value, 2)
+			if err == nil && p.min > 59 {
+				return start, p.error(spec, start, "minute out of range")
+			}
+		case "%S":
+			p.sec, value, err = p.getNum(value, 2)
+			if err == nil && p.sec > 59 {
+				return start, p.error(spec, start, "second out of range")
+			}
+			if err == nil && p.mode != ModeStrict && !decimalFollows(next) && startsWithDecimal(value) && len(value) > 1 && startsWithDigit(value[1:]) {
+				p.nsec, value, _ = getFraction(value[1:])
+			}
+		case "%f":
+			p.nsec, value, err = getFraction(value)
+			if err == nil && p.mode == ModeStrict && utf8.RuneCountInString(start[:len(start)-len(value)]) != 9 {
+				err = errParse
+			}
+		case "%p":
+			var i int
+			i, value, err = lookupName(value, [][]string{{p.locale.AM}, {p.locale.PM}})
+			p.pm = i + 1
+		case "%w":
+			_, value, err = lookupName(value, p.weekdayNames())
+		case "%z", "%:z":
+			p.offset, value, err = getOffset(value)
+			p.hasOffset = true
+		case "%Z":
+			p.zoneName, value, err = getZoneName(value)
+		default:
+			// Unknown specifiers are matched literally, as Format writes them.
+			value, err = p.literal(value, spec)
+		}
+		if errors.Is(err, errParse) {
+			return start, p.error(spec, start, p.reason(spec))
+		} else if err != nil {
+			return start, err
+		}
+	}
+	return value, nil
+}
+
+// error returns a *ParseError for the layout element elem, which failed to match
+// value, the remaining part of the value being parsed.
+func (p *parser) error(elem, value, reason string) error {
+	return &ParseError{
+		Layout:     p.layout,
+		Value:      p.value,
+		LayoutElem: elem,
+		ValueElem:  value,
+		Offset:     len(p.value) - len(value),
+		Reason:     reason,
+	}
+}
+
+// reason describes what the specifier spec expects.
+func (p *parser) reason(spec string) string {
+	switch spec {
+	case "%Y", "%y", "%m", "%d", "%H", "%I", "%M", "%S":
+		if p.mode == ModeStrict {
+			return fmt.Sprintf("expected a number of %d digits", numberWidth(spec))
+		}
+		return "expected a number"
+	case "%f":
+		if p.mode == ModeStrict {
+			return "expected fractional seconds of 9 digits"
+		}
+		return "expected fractional seconds"
+	case "%OY":
+		return "expected a number in Persian words"
+	case "%Od":
+		return "expected a day of the month in Persian words"
+	case "%B", "%b":
+		return "unknown month name"
+	case "%w":
+		return "unknown weekday name"
+	case "%p":
+		return fmt.Sprintf("expected %q or %q", p.locale.AM, p.locale.PM)
+	case "%z", "%:z":
+		return "expected a time zone offset"
+	case "%Z":
+		return "expected a time zone name"
+	}
+	return "text does not match the layout"
+}
+
+// time validates the parsed fields and returns the JalaliTime they describe.
+func (p *parser) time(loc *time.Location) (JalaliTime, error) {
+	if !p.hasYear {
+		p.year = 1
+	}
+	if !p.hasMonth {
+		p.month = int(Farvardin)
+	}
+	if !p.hasDay {
+		p.day = 1
+	}
+
+	switch {
+	case p.pm == 2 && p.hour < 12:
+		p.hour += 12
+	case p.pm == 1 && p.hour == 12:
+		p.hour = 0
+	}
+
+	if p.gregorian {
+		if !isValidGregorianDate(p.year, p.month, p.day) {
+			return JalaliTime{}, fmt.Errorf("invalid Gregorian date: %d/%02d/%02d", p.year, p.month, p.day)
+		}
+		var month Month
+		p.year, month, p.day = gregorianToJalali(p.year, time.Month(p.month), p.day)
+		p.month = int(month)
+	}
+
+	// Date accepts years up to 9999, so parsed times must not exceed them.
+	if !isValidJalaliDate(p.year, p.month, p.day) || p.year > 9999 {
+		return JalaliTime{}, fmt.Errorf("%w: %d/%02d/%02d", ErrInvalidDate, p.year, p.month, p.day)
+	}
+
+	loc, err := p.location(loc)
+	if err != nil {
+		return JalaliTime{}, err
+	}
+
+	return JalaliTime{
+		year:  p.year,
+		month: Month(p.month),
+		day:   p.day,
+		hour:  p.hour,
+		min:   p.min,
+		sec:   p.sec,
+		nsec:  p.nsec,
+		loc:   loc,
+	}, nil
+}
+
+// location returns the location of the parsed time: loc, unless the value
+// carried a zone offset or zone name that loc does not match.
+func (p *parser) location(loc *time.Location) (*time.Location, error) {
+	if !p.hasOffset && p.zoneName == "" {
+		return loc, nil
+	}
+
+	// offsetIn returns the offset of the parsed wall clock time in l.
+	gYear, gMonth, gDay := jalaliToGregorian(p.year, Month(p.month), p.day)
+	offsetIn := func(l *time.Location) int {
+		_, offset := time.Date(gYear, gMonth, gDay, p.hour, p.min, p.sec, p.nsec, l).Zone()
+		return offset
+	}
+
+	var zone *time.Location
+	if p.zoneName != "" {
+		name, _ := time.Date(gYear, gMonth, gDay, p.hour, p.min, p.sec, p.nsec, loc).Zone()
+		if p.zoneName == loc.String() || p.zoneName == name {
+			zone = loc
+		} else {
+			zone = lookupZone(p.zoneName)
+		}
+		if zone == nil && !p.hasOffset {
+			return nil, p.error("%Z", p.zoneName, "unknown time zone name")
+		}
+	}
+
+	switch {
+	case !p.hasOffset:
+		return zone, nil
+	case zone != nil && offsetIn(zone) == p.offset:
+		return zone, nil
+	case offsetIn(loc) == p.offset:
+		return loc, nil
+	case p.offset == 0 && p.zoneName == "":
+		return time.UTC, nil
+	}
+	return time.FixedZone(p.zoneName, p.offset), nil
+}
+
+// zoneAbbreviations maps common time zone abbreviations to their offsets in seconds east of UTC.
+var zoneAbbreviations = map[string]int{
+	"IRST": 12600, // Iran Standard Time
+	"IRDT": 16200, // Iran Daylight Time
+	"AFT":  16200, // Afghanistan Time
+	"GST":  14400, // Gulf Standard Time
+	"TRT":  10800, // Turkey Time
+	"MSK":  10800, // Moscow Time
+	"CET":  3600,  // Central European Time
+	"CEST": 7200,  // Central European Summer Time
+	"GMT":  0,
+	"UTC":  0,
+}
+
+// lookupZone returns the location named by an IANA time zone name or a known
+// abbreviation, or nil if the name is unknown.
+func lookupZone(name string) *time.Location {
+	switch name {
+	case "UTC", "Z":
+		return time.UTC
+	case "Local":
+		return time.Local
+	}
+	if offset, ok := zoneAbbreviations[name]; ok {
+		return time.FixedZone(name, offset)
+	}
+	if strings.Contains(name, "/") {
+		if loc, err := time.LoadLocation(name); err == nil {
+			return loc
+		}
+	}
+	return nil
+}
+
+// matchLiteral matches the literal layout text lit at the start of value. In
+// lenient mode, white space in lit matches any amount of white space, and white
+// space is skipped around the other characters of lit.
+func (p *parser) matchLiteral(value, lit string) (string, bool) {
+	if p.mode != ModeLenient {
+		if !strings.HasPrefix(value, lit) {
+			return value, false
+		}
+		return value[len(lit):], true
+	}
+
+	value = strings.TrimLeftFunc(value, unicode.IsSpace)
+	for _, r := range lit {
+		if unicode.IsSpace(r) {
+			continue
+		}
+		if !strings.HasPrefix(value, string(r)) {
+			return value, false
+		}
+		value = strings.TrimLeftFunc(value[utf8.RuneLen(r):], unicode.IsSpace)
+	}
+	return value, true
+}
+
+// getNum reads a number of at most maxWidth digits, or, in strict mode, of
+// exactly maxWidth digits.
+func (p *parser) getNum(value string, maxWidth int) (int, string, error) {
+	n, rest, err := getNum(value, maxWidth)
+	if err == nil && p.mode == ModeStrict && utf8.RuneCountInString(value[:len(value)-len(rest)]) != maxWidth {
+		return 0, value, errParse
+	}
+	return n, rest, err
+}
+
+// numberWidth returns the width Format writes for the numeric specifier spec.
+func numberWidth(spec string) int {
+	if spec == "%Y" {
+		return 4
+	}
+	return 2
+}
+
+// twoDigitYear returns the year, in the Jalali calendar, written as the last
+// two digits yy of a year of the locale's era. The year is the one within the
+// hundred years from 1300+pivot of the era, or from 1900+pivot for Gregorian dates.
+func (p *parser) twoDigitYear(yy int) int {
+	pivot := p.pivot
+	if pivot == 0 {
+		pivot = DefaultTwoDigitYearPivot
+	}
+	start := 1300 + p.locale.Era.Offset + pivot
+	if p.gregorian {
+		start = 1900 + pivot
+	}
+	return p.locale.Era.JalaliYear(start + ((yy-start)%100+100)%100)
+}
+
+// literal matches s at the start of value.
+func (p *parser) literal(value, s string) (string, error) {
+	if !strings.HasPrefix(value, s) {
+		return value, errParse
+	}
+	return value[len(s):], nil
+}
+
+// monthNames returns the month names accepted by %B and %b, indexed by Month:
+// the full and abbreviated names of the locale followed by monthAliases, or
+// gregorianMonthNames when reading a Gregorian date.
+func (p *parser) monthNames() [][]string {
+	if p.gregorian {
+		return gregorianMonthNames
+	}
+	names := make([][]string, len(monthAliases))
+	for m := Farvardin; m <= Esfand; m++ {
+		names[m] = append([]string{m.Name(p.locale), m.ShortName(p.locale)}, monthAliases[m]...)
+	}
+	return names
+}
+
+// weekdayNames returns the weekday names accepted by %w, indexed by Weekday.
+func (p *parser) weekdayNames() [][]string {
+	names := make([][]string, len(weekdayAliases))
+	for w := Yekshanbe; w <= Shanbe; w++ {
+		names[w] = append([]string{w.Name(p.locale)}, weekdayAliases[w]...)
+	}
+	return names
+}
+
+// monthAliases contains the month names accepted in every locale, indexed by Month.
+var monthAliases = [][]string{
+	nil,
+	{"فروردین", "Farvardin", "Farvardeen"},
+	{"اردیبهشت", "Ordibehesht", "Ordibehest"},
+	{"خرداد", "Khordad", "Khordaad"},
+	{"تیر", "Tir", "Teer"},
+	{"مرداد", "امرداد", "Mordad", "Amordad", "Mordaad", "Amordaad"},
+	{"شهریور", "Shahrivar", "Shahrevar"},
+	{"مهر", "Mehr"},
+	{"آبان", "ابان", "Aban", "Abaan"},
+	{"آذر", "اذر", "Azar", "Azer"},
+	{"دی", "Dey", "Dei"},
+	{"بهمن", "Bahman"},
+	{"اسفند", "Esfand", "Espand", "Esfandmah"},
+}
+
+// weekdayAliases contains the weekday names accepted in every locale, indexed by Weekday.
+var weekdayAliases = [][]string{
+	{"یکشنبه", "1Shanbeh", "Yekshanbe", "Yekshanbeh", "Sunday"},
+	{"دوشنبه", "2Shanbeh", "Doshanbe", "Doshanbeh", "Monday"},
+	{"سه‌شنبه", "3Shanbeh", "Seshanbe", "Seshanbeh", "Tuesday"},
+	{"چهارشنبه", "4Shanbeh", "Chaharshanbe", "Chaharshanbeh", "Wednesday"},
+	{"پنج‌شنبه", "5Shanbeh", "Panjshanbe", "Panjshanbeh", "Thursday"},
+	{"جمعه", "Joomeh", "Jomeh", "Jome", "Jomee", "Friday"},
+	{"شنبه", "Shanbeh", "Shanbe", "Saturday"},
+}
+
+// closeOptional returns the index of the %] closing an optional section whose
+// elements start at elems[i], or len(elems) if the section is not closed.
+func closeOptional(elems []layoutElem, i int) int {
+	depth := 0
+	for ; i < len(elems); i++ {
+		if !elems[i].spec {
+			continue
+		}
+		switch elems[i].text {
+		case "%[":
+			depth++
+		case "%]":
+			if depth == 0 {
+				return i
+			}
+			depth--
+		}
+	}
+	return i
+}
+
+// numberFollows reports whether elems start with a numeric specifier.
+func numberFollows(elems []layoutElem) bool {
+	if len(elems) == 0 || !elems[0].spec {
+		return false
+	}
+	return strings.IndexByte("YymdHIMS", elems[0].text[1]) >= 0
+}
+
+// decimalFollows reports whether elems start with a decimal separator.
+func decimalFollows(elems []layoutElem) bool {
+	return len(elems) > 0 && !elems[0].spec && startsWithDecimal(elems[0].text)
+}
+
+// getNum reads a decimal number of one to maxWidth digits from the start of value.
+// The digits may be ASCII, Persian or Arabic-Indic.
+func getNum(value string, maxWidth int) (int, string, error) {
+	n, i, width := 0, 0, 0
+	for i < len(value) && width < maxWidth {
+		r, size := utf8.DecodeRuneInString(value[i:])
+		d := digitValue(r)
+		if d < 0 {
+			break
+		}
+		n = n*10 + d
+		i += size
+		width++
+	}
+	if width == 0 {
+		return 0, value, errParse
+	}
+	return n, value[i:], nil
+}
+
+// digitValue returns the value of the ASCII, Persian or Arabic-Indic digit r, or -1.
+func digitValue(r rune) int {
+	switch {
+	case r >= '0' && r <= '9':
+		return int(r - '0')
+	case r >= '۰' && r <= '۹':
+		return int(r - '۰')
+	case r >= '٠' && r <= '٩':
+		return int(r - '٠')
+	}
+	return -1
+}
+
+// lookupName returns the index of the entry of names holding the longest name
+// that value starts with, as matched by matchName.
+func lookupName(value string, names [][]string) (int, string, error) {
+	best, bestLen := -1, 0
+	for i, variants := range names {
+		for _, name := range variants {
+			if n, ok := matchName(value, name); ok && n > bestLen {
+				best, bestLen = i, n
+			}
+		}
+	}
+	if best < 0 {
+		return 0, value, errParse
+	}
+	return best, value[bestLen:], nil
+}
+
+// matchName reports whether value starts with name and returns the length of
+// the match in value. Letters are compared after foldLetter, and a zero-width
+// non-joiner or space inside the name may be written as either or omitted.
+// Empty names never match.
+func matchName(value, name string) (int, bool) {
+	if name == "" {
+		return 0, false
+	}
+	i, j := 0, 0
+	for j < len(name) {
+		nr, nsize := utf8.DecodeRuneInString(name[j:])
+		if nr == zwnj || nr == ' ' {
+			// The separator is optional in value.
+			j += nsize
+			if i < len(value) && (value[i] == ' ' || strings.HasPrefix(value[i:], string(zwnj))) {
+				_, size := utf8.DecodeRuneInString(value[i:])
+				i += size
+			}
+			continue
+		}
+		if i >= len(value) {
+			return 0, false
+		}
+		vr, vsize := utf8.DecodeRuneInString(value[i:])
+		if (vr == zwnj || vr == ' ') && i > 0 && j > 0 {
+			// A separator in value that the name does not have.
+			i += vsize
+			continue
+		}
+		if foldLetter(vr) != foldLetter(nr) {
+			return 0, false
+		}
+		i += vsize
+		j += nsize
+	}
+	return i, true
+}
+
+// zwnj is the zero-width non-joiner used inside Persian words.
+const zwnj = '\u200c'
+
+// foldLetter maps Arabic letter variants to their Persian forms and letters to lower case.
+func foldLetter(r rune) rune {
+	switch r {
+	case 'ي', 'ى':
+		return 'ی'
+	case 'ك':
+		return 'ک'
+	}
+	return unicode.ToLower(r)
+}
+
+// Normalize returns s with Persian and Arabic-Indic digits replaced by ASCII
+// digits and the Arabic letters ي, ى and ك replaced by their Persian forms ی and ک.
+func Normalize(s string) string {
+	return strings.Map(func(r rune) rune {
+		if d := digitValue(r); d >= 0 {
+			return '0' + rune(d)
+		}
+		if r == 'ي' || r == 'ى' || r == 'ك' {
+			return foldLetter(r)
+		}
+		return r
+	}, s)
+}
+
+// getOffset reads a time zone offset of the form ±hhmm, ±hh:mm or Z from
+// the start of value and returns it in seconds east of UTC.
+func getOffset(value string) (int, string, error) {
+	if strings.HasPrefix(value, "Z") {
+		return 0, value[1:], nil
+	}
+	if value == "" || (value[0] != '+' && value[0] != '-') {
+		return 0, value, errParse
+	}
+	sign := 1
+	if value[0] == '-' {
+		sign = -1
+	}
+
+	hours, rest, ok := getTwoDigits(value[1:])
+	if !ok {
+		return 0, value, errParse
+	}
+	minutes, rest, ok := getTwoDigits(strings.TrimPrefix(rest, ":"))
+	if !ok || hours > 23 || minutes > 59 {
+		return 0, value, errParse
+	}
+	return sign * (hours*3600 + minutes*60), rest, nil
+}
+
+// getTwoDigits reads a number of exactly two ASCII, Persian or Arabic-Indic
+// digits from the start of value.
+func getTwoDigits(value string) (int, string, bool) {
+	n, rest, err := getNum(value, 2)
+	if err != nil || utf8.RuneCountInString(value[:len(value)-len(rest)]) != 2 {
+		return 0, value, false
+	}
+	return n, rest, true
+}
+
+// getZoneName reads a time zone name, such as "Asia/Tehran" or "IRST", from the start of value.
+func getZoneName(value string) (string, string, error) {
+	i := 0
+	for i < len(value) && isZoneNameByte(value[i]) {
+		// A sign and a digit after a name start an offset, as in "IRST+0330",
+		// except in the names Etc/GMT+3 and Etc/GMT-3.
+		if i > 0 && (value[i] == '+' || value[i] == '-') && startsWithDigit(value[i+1:]) &&
+			!strings.HasSuffix(value[:i], "Etc/GMT") {
+			break
+		}
+		i++
+	}
+	if i == 0 {
+		return "", value, errParse
+	}
+	return value[:i], value[i:], nil
+}
+
+// getFraction reads the digits of a fraction of a second, such as "5" or
+// "123456789", and returns it in nanoseconds. Digits beyond the ninth are read
+// and discarded. The digits may be ASCII, Persian or Arabic-Indic.
+func getFraction(value string) (int, string, error) {
+	nsec, i, width := 0, 0, 0
+	for i < len(value) {
+		r, size := utf8.DecodeRuneInString(value[i:])
+		d := digitValue(r)
+		if d < 0 {
+			break
+		}
+		if width < 9 {
+			nsec = nsec*10 + d
+		}
+		i += size
+		width++
+	}
+	if width == 0 {
+		return 0, value, errParse
+	}
+	for n := width; n < 9; n++ {
+		nsec *= 10
+	}
+	return nsec, value[i:], nil
+}
+
+// startsWithDecimal reports whether s starts with a decimal separator.
+func startsWithDecimal(s string) bool {
+	return strings.HasPrefix(s, ".") || strings.HasPrefix(s, ",")
+}
+
+// startsWithDigit reports whether s starts with an ASCII, Persian or
+// Arabic-Indic digit.
+func startsWithDigit(s string) bool {
+	r, _ := utf8.DecodeRuneInString(s)
+	return digitValue(r) >= 0
+}
+
+// isZoneNameByte reports whether c can appear in a time zone name.
+func isZoneNameByte(c byte) bool {
+	return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' ||
+		c == '/' || c == '_' || c == '-' || c == '+'
+}
+
+// persianOrdinalDays contains the Persian ordinal words for the days of a month,
+// indexed by day, as written by %Od. The first day may also be written "اول".
+var persianOrdinalDays = func() [][]string {
+	days := make([][]string, 32)
+	for d := 1; d <= 31; d++ {
+		days[d] = []string{PersianOrdinalWords(int64(d))}
+	}
+	days[1] = append(days[1], "اول")
+	return days
+}()
+
+// faNumberWords maps the Persian number words below a thousand to their values.
+var faNumberWords = func() map[string]int {
+	words := make(map[string]int)
+	for i, w := range faOnes {
+		words[w] = i
+	}
+	for i, w := range faTens {
+		if w != "" {
+			words[w] = i * 10
+		}
+	}
+	for i, w := range faHundreds {
+		if w != "" {
+			words[w] = i * 100
+		}
+	}
+	return words
+}()
+
+// getPersianWords reads a number written in Persian words, as by PersianWords,
+// from the start of value.
+func getPersianWords(value string) (int, string, error) {
+	total, current := 0, 0
+	end := -1 // end of the last number word read
+	i := 0
+	for {
+		j := i
+		for j < len(value) && value[j] != ' ' {
+			j++
+		}
+		word := value[i:j]
+
+		if v, ok := faNumberWords[word]; ok {
+			current += v
+		} else if scale := scaleIndex(word); scale > 0 {
+			if current == 0 {
+				current = 1
+			}
+			for k := 0; k < scale; k++ {
+				current *= 1000
+			}
+			total += current
+			current = 0
+		} else {
+			break
+		}
+		end = j
+
+		// Numbers continue after " و " or, before a scale word, a single space.
+		if strings.HasPrefix(value[j:], " و ") {
+			i = j + len(" و ")
+		} else if strings.HasPrefix(value[j:], " ") {
+			i = j + 1
+		} else {
+			break
+		}
+	}
+	if end < 0 {
+		return 0, value, errParse
+	}
+	return total + current, value[end:], nil
+}
+
+// scaleIndex returns the power of a thousand named by word, or 0 if word is not a scale word.
+func scaleIndex(word string) int {
+	for i, w := range faScales {
+		if i > 0 && w == word {
+			return i
+		}
+	}
+	return 0
+}
@@ -0,0 +1,438 @@
+/*
+MIT License
+
+Copyright (c) 2023 Mohammad Shafiee <muhammad.shafiee@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+
+package jalali
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParseInLocationFieldOrder(t *testing.T) {
+	want := Date(1402, Mordad, 20, 16, 30, 45, 0, time.UTC)
+
+	tests := []struct {
+		layout string
+		value  string
+	}{
+		{"%Y/%m/%d %H:%M:%S", "1402/05/20 16:30:45"},
+		{"%d/%m/%Y %H:%M:%S", "20/05/1402 16:30:45"},
+		{"%H:%M:%S %d-%m-%Y", "16:30:45 20-05-1402"},
+		{"%d %B %Y %T", "20 مرداد 1402 16:30:45"},
+		{"%B %d, %Y %R:%S", "مرداد 20, 1402 16:30:45"},
+		{"%Y%m%d%H%M%S", "14020520163045"},
+		{"%Y/%m/%d %H:%M:%S", "1402/5/20 16:30:45"},
+		{"%w %Y/%m/%d %H:%M:%S", "جمعه 1402/05/20 16:30:45"},
+	}
+
+	for _, tc := range tests {
+		got, err := ParseInLocation(tc.layout, tc.value, time.UTC)
+		if err != nil {
+			t.Errorf("ParseInLocation(%q, %q) error = %v", tc.layout, tc.value, err)
+			continue
+		}
+		if got != want {
+			t.Errorf("ParseInLocation(%q, %q) = %v, want %v", tc.layout, tc.value, got, want)
+		}
+	}
+}
+
+func TestParseInLocationOptionalFields(t *testing.T) {
+	tests := []struct {
+		layout string
+		value  string
+		want   JalaliTime
+	}{
+		{"%Y/%m/%d", "1402/05/20", Date(1402, Mordad, 20, 0, 0, 0, 0, time.UTC)},
+		{"%Y/%m/%d%[ %H:%M%]", "1402/05/20", Date(1402, Mordad, 20, 0, 0, 0, 0, time.UTC)},
+		{"%Y/%m/%d%[ %H:%M%[:%S%]%]", "1402/05/20 16:30", Date(1402, Mordad, 20, 16, 30, 0, 0, time.UTC)},
+		{"%Y/%m/%d%[ %H:%M%[:%S%]%]", "1402/05/20 16:30:45", Date(1402, Mordad, 20, 16, 30, 45, 0, time.UTC)},
+		{"%Y/%m", "1402/05", Date(1402, Mordad, 1, 0, 0, 0, 0, time.UTC)},
+		{"%H:%M", "16:30", Date(1, Farvardin, 1, 16, 30, 0, 0, time.UTC)},
+		{"%Y/%m/%d %H %p", "1402/05/20 04 عصر", Date(1402, Mordad, 20, 16, 0, 0, 0, time.UTC)},
+		{"%Od %B %OY", "بیستم مرداد یک هزار و چهارصد و دو", Date(1402, Mordad, 20, 0, 0, 0, 0, time.UTC)},
+		{"%OY %Od %B", "یک هزار و چهارصد و دو سی‌ام مهر", Date(1402, Mehr, 30, 0, 0, 0, 0, time.UTC)},
+	}
+
+	for _, tc := range tests {
+		got, err := ParseInLocation(tc.layout, tc.value, time.UTC)
+		if err != nil {
+			t.Errorf("ParseInLocation(%q, %q) error = %v", tc.layout, tc.value, err)
+			continue
+		}
+		if got != tc.want {
+			t.Errorf("ParseInLocation(%q, %q) = %v, want %v", tc.layout, tc.value, got, tc.want)
+		}
+	}
+}
+
+func TestParseInLocationErrors(t *testing.T) {
+	tests := []struct {
+		layout string
+		value  string
+	}{
+		{"%Y/%m/%d", "1402-05-20"},
+		{"%Y/%m/%d", "1402/05/20 16:30"},
+		{"%Y/%m/%d", "1402/05"},
+		{"%d %B %Y", "20 Mordadd 1402"},
+		{"%Y/%m/%d %H:%M", "1402/05/20 24:00"},
+		{"%Y/%m/%d", "1402/12/30"},
+		{"%Y/%m/%d %z", "1402/05/20 +03"},
+	}
+
+	for _, tc := range tests {
+		if got, err := ParseInLocation(tc.layout, tc.value, time.UTC); err == nil {
+			t.Errorf("ParseInLocation(%q, %q) = %v, want error", tc.layout, tc.value, got)
+		}
+	}
+}
+
+func TestParseFormatRoundTrip(t *testing.T) {
+	layouts := []string{
+		"%Y/%m/%d %H:%M:%S",
+		"%Y-%m-%d %T %p",
+		"%d %B %Y %R:%S",
+		"%d %b %Y %T",
+		"%w %d %B %Y %T",
+		"%S:%M:%H %d/%m/%Y",
+		"%Y%m%d%H%M%S",
+		"%Od %B %OY %T",
+		"%Y/%m/%d %T %z %Z",
+		"%Y/%m/%d %T %Z%z",
+		"%Y-%m-%dT%T.%f%z",
+		"%Y/%m/%d%[ %T%]",
+		"%%Y=%Y%n%%m=%m%n%%d=%d%n%T",
+	}
+	locales := []*Locale{LocalePersian, LocaleEnglish, LocaleDari, LocalePashto, LocaleSorani, LocaleKurmanji}
+	loc := time.FixedZone("IRST", 12600)
+	times := []JalaliTime{
+		Date(1402, Mordad, 20, 16, 30, 45, 0, loc),
+		Date(1399, Esfand, 30, 0, 0, 0, 0, loc),
+		Date(1, Farvardin, 1, 12, 0, 0, 0, loc),
+		Date(1300, Dey, 9, 23, 59, 59, 0, loc),
+		Date(9999, Bahman, 11, 11, 11, 11, 0, loc),
+	}
+	for m := Farvardin; m <= Esfand; m++ {
+		times = append(times, Date(1402, m, 15, 8, 5, 0, 0, loc))
+	}
+
+	for _, l := range locales {
+		for _, layout := range layouts {
+			for _, j := range times {
+				if layout == "%Y%m%d%H%M%S" && j.EraYear(l.Era) > 9999 {
+					// Five-digit years cannot be told apart from the month without a separator.
+					continue
+				}
+				value := j.FormatLocale(layout, l)
+				got, err := ParseInLocale(layout, value, loc, l)
+				if err != nil {
+					t.Errorf("ParseInLocale(%q, %q, %s) error = %v", layout, value, l.Tag, err)
+					continue
+				}
+				if got != j {
+					t.Errorf("ParseInLocale(%q, %q, %s) = %v, want %v", layout, value, l.Tag, got, j)
+				}
+			}
+		}
+	}
+}
+
+func TestParseInLocationPersianInput(t *testing.T) {
+	want := Date(1402, Mordad, 20, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		layout string
+		value  string
+	}{
+		{"%d %B %Y", "۲۰ مرداد ۱۴۰۲"},
+		{"%d/%m/%Y", "٢٠/٠٥/١٤٠٢"},
+		{"%Y/%m/%d", "۱۴۰۲/5/٢٠"},
+		{"%d %B %Y", "20 امرداد 1402"},
+		{"%d %B %Y", "20 Mordad 1402"},
+		{"%d %B %Y", "20 amordad 1402"},
+		{"%d %b %Y", "20 MORDAD 1402"},
+		{"%w %d %B %Y", "جمعه ۲۰ مرداد ۱۴۰۲"},
+		{"%w %d %B %Y", "Jomeh 20 Mordad 1402"},
+		{"%w %d %B %Y", "Friday 20 Mordad 1402"},
+	}
+
+	for _, tc := range tests {
+		got, err := ParseInLocation(tc.layout, tc.value, time.UTC)
+		if err != nil {
+			t.Errorf("ParseInLocation(%q, %q) error = %v", tc.layout, tc.value, err)
+			continue
+		}
+		if got != want {
+			t.Errorf("ParseInLocation(%q, %q) = %v, want %v", tc.layout, tc.value, got, want)
+		}
+	}
+}
+
+func TestParseInLocationNameVariants(t *testing.T) {
+	tests := []struct {
+		layout string
+		value  string
+		want   JalaliTime
+	}{
+		{"%d %B %Y", "1 Ordibehest 1402", Date(1402, Ordibehesht, 1, 0, 0, 0, 0, time.UTC)},
+		{"%d %B %Y", "1 اردیبهشت 1402", Date(1402, Ordibehesht, 1, 0, 0, 0, 0, time.UTC)},
+		{"%d %B %Y", "1 آبان 1402", Date(1402, Aban, 1, 0, 0, 0, 0, time.UTC)},
+		{"%d %B %Y", "1 ابان 1402", Date(1402, Aban, 1, 0, 0, 0, 0, time.UTC)},
+		{"%d %B %Y", "1 دي 1402", Date(1402, Dey, 1, 0, 0, 0, 0, time.UTC)},
+		{"%w %Y/%m/%d", "سه‌شنبه 1402/05/17", Date(1402, Mordad, 17, 0, 0, 0, 0, time.UTC)},
+		{"%w %Y/%m/%d", "سه شنبه 1402/05/17", Date(1402, Mordad, 17, 0, 0, 0, 0, time.UTC)},
+		{"%w %Y/%m/%d", "سهشنبه 1402/05/17", Date(1402, Mordad, 17, 0, 0, 0, 0, time.UTC)},
+		{"%w %Y/%m/%d", "يک‌شنبه 1402/05/15", Date(1402, Mordad, 15, 0, 0, 0, 0, time.UTC)},
+		{"%Od %B %Y", "اول مهر 1402", Date(1402, Mehr, 1, 0, 0, 0, 0, time.UTC)},
+	}
+
+	for _, tc := range tests {
+		got, err := ParseInLocation(tc.layout, tc.value, time.UTC)
+		if err != nil {
+			t.Errorf("ParseInLocation(%q, %q) error = %v", tc.layout, tc.value, err)
+			continue
+		}
+		if got != tc.want {
+			t.Errorf("ParseInLocation(%q, %q) = %v, want %v", tc.layout, tc.value, got, tc.want)
+		}
+	}
+}
+
+func TestNameAliasesDistinct(t *testing.T) {
+	for _, aliases := range [][][]string{monthAliases, weekdayAliases} {
+		seen := make(map[string]bool)
+		for _, names := range aliases {
+			for _, name := range names {
+				if seen[strings.ToLower(name)] {
+					t.Errorf("name %q is listed twice", name)
+				}
+				seen[strings.ToLower(name)] = true
+			}
+		}
+	}
+
+	// "Day" is an English word, not a spelling of Dey.
+	if _, err := ParseInLocation("%d %B %Y", "1 Day 1402", time.UTC); err == nil {
+		t.Error(`ParseInLocation() accepted "Day" as a month`)
+	}
+}
+
+func TestNormalize(t *testing.T) {
+	if got, want := Normalize("۲۰ مرداد ١٤٠٢ يك"), "20 مرداد 1402 یک"; got != want {
+		t.Errorf("Normalize() = %q, want %q", got, want)
+	}
+}
+
+func TestParseError(t *testing.T) {
+	tests := []struct {
+		layout     string
+		value      string
+		layoutElem string
+		valueElem  string
+		offset     int
+		message    string
+	}{
+		{
+			"%Y/%m/%d", "1402-05-20", "/", "-05-20", 4,
+			`parsing time "1402-05-20" as "%Y/%m/%d": cannot parse "-05-20" as "/": text does not match the layout`,
+		},
+		{
+			"%d %B %Y", "20 Mordadd 1402", " ", "d 1402", 9,
+			`parsing time "20 Mordadd 1402" as "%d %B %Y": cannot parse "d 1402" as " ": text does not match the layout`,
+		},
+		{
+			"%d %B %Y", "20 Xyz 1402", "%B", "Xyz 1402", 3,
+			`parsing time "20 Xyz 1402" as "%d %B %Y": cannot parse "Xyz 1402" as "%B": unknown month name`,
+		},
+		{
+			"%Y/%m/%d %T", "1402/05/20 24:00:00", "%H", "24:00:00", 11,
+			`parsing time "1402/05/20 24:00:00" as "%Y/%m/%d %T": cannot parse "24:00:00" as "%H": hour out of range`,
+		},
+		{
+			"%Y/%m/%d", "۱۴۰۲/۰۵/۲۰ 16:30", "", " 16:30", 18,
+			`parsing time "۱۴۰۲/۰۵/۲۰ 16:30" as "%Y/%m/%d": extra text " 16:30"`,
+		},
+	}
+
+	for _, tc := range tests {
+		_, err := ParseInLocation(tc.layout, tc.value, time.UTC)
+		var perr *ParseError
+		if !errors.As(err, &perr) {
+			t.Errorf("ParseInLocation(%q, %q) error = %v, want *ParseError", tc.layout, tc.value, err)
+			continue
+		}
+		if perr.LayoutElem != tc.layoutElem {
+			t.Errorf("LayoutElem = %q, want %q", perr.LayoutElem, tc.layoutElem)
+		}
+		if perr.Layout != tc.layout || perr.Value != tc.value {
+			t.Errorf("Layout, Value = %q, %q, want %q, %q", perr.Layout, perr.Value, tc.layout, tc.value)
+		}
+		if perr.ValueElem != tc.valueElem || perr.Offset != tc.offset {
+			t.Errorf("ValueElem, Offset = %q, %d, want %q, %d", perr.ValueElem, perr.Offset, tc.valueElem, tc.offset)
+		}
+		if perr.Value[perr.Offset:] != perr.ValueElem {
+			t.Errorf("Offset %d does not point at ValueElem %q", perr.Offset, perr.ValueElem)
+		}
+		if err.Error() != tc.message {
+			t.Errorf("Error() = %v, want %v", err, tc.message)
+		}
+	}
+}
+
+func TestParseInvalidDate(t *testing.T) {
+	// 1402 is a common year, so Esfand has 29 days.
+	_, err := Parse("%Y/%m/%d", "1402/12/30")
+	if !errors.Is(err, ErrInvalidDate) {
+		t.Errorf("Parse() error = %v, want ErrInvalidDate", err)
+	}
+	var perr *ParseError
+	if errors.As(err, &perr) {
+		t.Errorf("Parse() error = %v, should not be a *ParseError", err)
+	}
+
+	if _, err := Parse("%Y/%m/%d", "1403/12/30"); err != nil {
+		t.Errorf("Parse() error = %v for a leap year", err)
+	}
+
+	// Date rejects years above 9999, and so must Parse.
+	for _, value := range []string{"999999999/05/20", "10000/01/01"} {
+		if _, err := ParseInLocation("%Y/%m/%d", value, time.UTC); !errors.Is(err, ErrInvalidDate) {
+			t.Errorf("ParseInLocation(%q) error = %v, want ErrInvalidDate", value, err)
+		}
+	}
+	if _, err := Parse("%Y/%m/%d", "9999/12/29"); err != nil {
+		t.Errorf("Parse() error = %v for year 9999", err)
+	}
+}
+
+func TestParseZone(t *testing.T) {
+	tehran, err := time.LoadLocation("Asia/Tehran")
+	if err != nil {
+		t.Skipf("time zone database not available: %v", err)
+	}
+	loc := time.FixedZone("IRST", 12600)
+
+	tests := []struct {
+		layout   string
+		value    string
+		loc      *time.Location
+		wantLoc  string
+		wantUnix int64
+	}{
+		// 1402/05/20 16:30:00 at +03:30 is 2023-08-11 13:00:00 UTC.
+		{"%Y/%m/%d %H:%M %z", "1402/05/20 16:30 +0330", time.UTC, "", 1691758800},
+		{"%Y/%m/%d %H:%M %z", "1402/05/20 16:30 +03:30", time.UTC, "", 1691758800},
+		{"%Y/%m/%d %H:%M %z", "1402/05/20 13:00 Z", loc, "UTC", 1691758800},
+		{"%Y/%m/%d %H:%M %z", "1402/05/20 13:00 +0000", loc, "UTC", 1691758800},
+		{"%Y/%m/%d %H:%M %z", "1402/05/20 16:30 +0330", loc, "IRST", 1691758800},
+		{"%Y/%m/%d %H:%M %z", "1402/05/20 08:00 -0500", loc, "", 1691758800},
+		{"%Y/%m/%d %H:%M %Z", "1402/05/20 16:30 Asia/Tehran", time.UTC, "Asia/Tehran", 1691758800},
+		{"%Y/%m/%d %H:%M %Z", "1402/05/20 16:30 IRST", time.UTC, "IRST", 1691758800},
+		{"%Y/%m/%d %H:%M %Z", "1402/05/20 13:00 UTC", loc, "UTC", 1691758800},
+		{"%Y/%m/%d %H:%M %Z %z", "1402/05/20 16:30 Asia/Tehran +0330", time.UTC, "Asia/Tehran", 1691758800},
+		{"%Y/%m/%d %H:%M %Z %z", "1402/05/20 16:30 XYZ +0330", time.UTC, "XYZ", 1691758800},
+		{"%Y/%m/%d %H:%M", "1402/05/20 16:30", tehran, "Asia/Tehran", 1691758800},
+		{"%Y/%m/%d %H:%M %Z%z", "1402/05/20 16:30 IRST+0330", time.UTC, "IRST", 1691758800},
+		{"%Y/%m/%d %H:%M %Z%z", "1402/05/20 16:30 +0330+0330", time.UTC, "+0330", 1691758800},
+		{"%Y/%m/%d %H:%M %Z", "1402/05/20 16:00 Etc/GMT-3", time.UTC, "Etc/GMT-3", 1691758800},
+		{"%Y/%m/%d %H:%M %Z%z", "۱۴۰۲/۰۵/۲۰ ۱۶:۳۰ IRST+۰۳:۳۰", time.UTC, "IRST", 1691758800},
+		{"%Y/%m/%d %H:%M %Z%z", "1402/05/20 16:00 Etc/GMT-3+0300", time.UTC, "Etc/GMT-3", 1691758800},
+	}
+
+	for _, tc := range tests {
+		got, err := ParseInLocation(tc.layout, tc.value, tc.loc)
+		if err != nil {
+			t.Errorf("ParseInLocation(%q, %q) error = %v", tc.layout, tc.value, err)
+			continue
+		}
+		if got.Unix() != tc.wantUnix {
+			t.Errorf("ParseInLocation(%q, %q).Unix() = %d, want %d", tc.layout, tc.value, got.Unix(), tc.wantUnix)
+		}
+		if got.Location().String() != tc.wantLoc {
+			t.Errorf("ParseInLocation(%q, %q).Location() = %q, want %q", tc.layout, tc.value, got.Location(), tc.wantLoc)
+		}
+	}
+
+	// A nil location is the local time zone, as in Date.
+	got, err := ParseInLocation("%Y/%m/%d %H:%M %z", "1402/05/20 16:30 +0330", nil)
+	if err != nil || got.Unix() != 1691758800 {
+		t.Errorf("ParseInLocation() with a nil location = %v, %v, want Unix time 1691758800", got, err)
+	}
+	if got, err := ParseInLocation("%Y/%m/%d %H:%M %Z", "1402/05/20 16:30 Asia/Tehran", nil); err != nil || got.Unix() != 1691758800 {
+		t.Errorf("ParseInLocation() with a nil location = %v, %v, want Unix time 1691758800", got, err)
+	}
+	if got, err := ParseInLocation("%Y/%m/%d", "1402/05/20", nil); err != nil || got.Location() != time.Local {
+		t.Errorf("ParseInLocation() with a nil location = %v, %v, want the local time zone", got, err)
+	}
+
+	for _, value := range []string{"1402/05/20 16:30 Mars/Olympus", "1402/05/20 16:30 XYZ"} {
+		if _, err := ParseInLocation("%Y/%m/%d %H:%M %Z", value, time.UTC); err == nil {
+			t.Errorf("ParseInLocation(%q) accepted an unknown time zone", value)
+		}
+	}
+}
+
+func TestParseFractionalSeconds(t *testing.T) {
+	tests := []struct {
+		layout string
+		value  string
+		nsec   int
+	}{
+		{"%Y/%m/%d %T.%f", "1402/05/20 16:30:45.123456789", 123456789},
+		{"%Y/%m/%d %T.%f", "1402/05/20 16:30:45.5", 500000000},
+		{"%Y/%m/%d %T.%f", "1402/05/20 16:30:45.0000000019", 1},
+		{"%Y/%m/%d %T", "1402/05/20 16:30:45.25", 250000000},
+		{"%Y/%m/%d %T", "1402/05/20 16:30:45,001", 1000000},
+		{"%Y/%m/%d %H:%M:%S %p", "1402/05/20 16:30:45.75 عصر", 750000000},
+		{"%Y/%m/%d %T.%f", "۱۴۰۲/۰۵/۲۰ ۱۶:۳۰:۴۵.۱۲۵", 125000000},
+		{"%Y/%m/%d %T", "۱۴۰۲/۰۵/۲۰ ۱۶:۳۰:۴۵.۲۵", 250000000},
+		{"%Y/%m/%d %T.%f", "1402/05/20 16:30:45.٠٠٥", 5000000},
+	}
+
+	for _, tc := range tests {
+		got, err := ParseInLocation(tc.layout, tc.value, time.UTC)
+		if err != nil {
+			t.Errorf("ParseInLocation(%q, %q) error = %v", tc.layout, tc.value, err)
+			continue
+		}
+		if got.nsec != tc.nsec || got.sec != 45 {
+			t.Errorf("ParseInLocation(%q, %q) = %d.%09d, want 45.%09d", tc.layout, tc.value, got.sec, got.nsec, tc.nsec)
+		}
+	}
+
+	j := Date(1402, Mordad, 20, 16, 30, 45, 1234, time.UTC)
+	layout := "%Y-%m-%dT%H:%M:%S.%f%z"
+	if got, err := ParseInLocation(layout, j.Format(layout), time.UTC); err != nil || got != j {
+		t.Errorf("ParseInLocation(%q, %q) = %v, %v, want %v", layout, j.Format(layout), got, err, j)
+	}
+	if got, err := ParseInLocation(layout, ToPersianDigits(j.Format(layout)), time.UTC); err != nil || got != j {
+		t.Errorf("ParseInLocation(%q, %q) = %v, %v, want %v", layout, ToPersianDigits(j.Format(layout)), got, err, j)
+	}
+	strict := ParseOptions{Mode: ModeStrict}
+	if _, err := strict.ParseInLocation("%T.%f", "16:30:45.۱۲۳۴۵۶۷۸۹", time.UTC); err != nil {
+		t.Errorf("strict ParseInLocation() with nine Persian digits error = %v", err)
+	}
+}
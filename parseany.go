@@ -0,0 +1,180 @@
+/*
+MIT License
+
+Copyright (c) 2023 Mohammad Shafiee <muhammad.shafiee@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+
+package jalali
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// ErrAmbiguousDate is returned by ParseAny when a value such as "05/06/1402" can
+// be read with the day first or with the month first and no order was preferred.
+var ErrAmbiguousDate = errors.New("ambiguous day and month order")
+
+// DateOrder is the order of the day and the month in a numeric date.
+type DateOrder int
+
+const (
+	// OrderUnspecified rejects values whose day and month order is ambiguous.
+	OrderUnspecified DateOrder = iota
+	// DayFirst reads ambiguous values with the day before the month.
+	DayFirst
+	// MonthFirst reads ambiguous values with the month before the day.
+	MonthFirst
+)
+
+// DefaultLayouts are the layouts tried by ParseAny, in order.
+var DefaultLayouts = []string{
+	"%Y/%m/%d%[ %H:%M%[:%S%]%]",
+	"%Y-%m-%d%[ %H:%M%[:%S%]%]",
+	"%Y-%m-%dT%H:%M%[:%S%]%[%z%]",
+	"%Y.%m.%d%[ %H:%M%[:%S%]%]",
+	"%d/%m/%Y%[ %H:%M%[:%S%]%]",
+	"%m/%d/%Y%[ %H:%M%[:%S%]%]",
+	"%d-%m-%Y%[ %H:%M%[:%S%]%]",
+	"%m-%d-%Y%[ %H:%M%[:%S%]%]",
+	"%d.%m.%Y%[ %H:%M%[:%S%]%]",
+	"%m.%d.%Y%[ %H:%M%[:%S%]%]",
+	"%Y%m%d",
+	"%d %B %Y%[ %H:%M%[:%S%]%]",
+	"%w %d %B %Y%[ %H:%M%[:%S%]%]",
+	"%B %d, %Y%[ %H:%M%[:%S%]%]",
+	"%Y %B %d%[ %H:%M%[:%S%]%]",
+	"%Od %B %OY",
+}
+
+// AnyParser parses values written in any of several layouts.
+type AnyParser struct {
+	// Layouts are tried in order; the first that matches is used. If nil,
+	// DefaultLayouts is used.
+	Layouts []string
+
+	// Order chooses between layouts that read the same value with the day and
+	// the month swapped. With OrderUnspecified such values are rejected.
+	Order DateOrder
+
+	// Locale is used to match names. If nil, LocalePersian is used.
+	Locale *Locale
+}
+
+// ParseAny parses a value written in any of DefaultLayouts in the given
+// location, and returns the layout that matched. See AnyParser.Parse.
+func ParseAny(value string, loc *time.Location) (JalaliTime, string, error) {
+	return (&AnyParser{}).Parse(value, loc)
+}
+
+// Parse parses value with the first of the parser's layouts that matches it,
+// ignoring leading and trailing white space, and returns the layout that matched.
+// If a layout with the day and month in the opposite order also matches and
+// gives a different date, the value is ambiguous: the layout whose order matches
+// p.Order is used, or, without an order, an error wrapping ErrAmbiguousDate is
+// returned. If no layout matches, the error is the one from the layout that
+// matched the longest part of the value.
+func (p *AnyParser) Parse(value string, loc *time.Location) (JalaliTime, string, error) {
+	return p.parse(value, loc, false)
+}
+
+// parse implements Parse, reading the date fields as a Gregorian date if
+// gregorian is set.
+func (p *AnyParser) parse(value string, loc *time.Location, gregorian bool) (JalaliTime, string, error) {
+	layouts := p.Layouts
+	if layouts == nil {
+		layouts = DefaultLayouts
+	}
+	l := p.Locale
+	if l == nil {
+		l = LocalePersian
+	}
+	if gregorian {
+		// Gregorian years are never written in a Jalali era.
+		l = l.WithEra(Era{})
+	}
+	value = strings.TrimSpace(value)
+
+	var (
+		match       JalaliTime
+		matchLayout string
+		matched     bool
+		bestErr     error
+		bestOffset  = -1
+	)
+	for _, layout := range layouts {
+		j, err := parseLayout(layout, value, loc, l, gregorian)
+		if err != nil {
+			var perr *ParseError
+			switch {
+			case errors.Is(err, ErrInvalidDate):
+				// A value that matches but names no real day beats any mismatch.
+				bestErr, bestOffset = err, len(value)+1
+			case errors.As(err, &perr) && perr.Offset > bestOffset:
+				bestErr, bestOffset = err, perr.Offset
+			}
+			continue
+		}
+
+		if !matched {
+			match, matchLayout, matched = j, layout, true
+			continue
+		}
+		first, order := layoutOrder(matchLayout), layoutOrder(layout)
+		if j == match || first == OrderUnspecified || order == OrderUnspecified || first == order {
+			continue
+		}
+
+		// The value reads differently with the day and month swapped.
+		switch p.Order {
+		case first:
+			return match, matchLayout, nil
+		case order:
+			return j, layout, nil
+		}
+		return JalaliTime{}, "", fmt.Errorf("%w: %q matches %q and %q", ErrAmbiguousDate, value, matchLayout, layout)
+	}
+
+	if !matched {
+		if bestErr == nil {
+			bestErr = fmt.Errorf("no layout to parse %q", value)
+		}
+		return JalaliTime{}, "", bestErr
+	}
+	return match, matchLayout, nil
+}
+
+// layoutOrder returns the order of the day and the month in layout, or
+// OrderUnspecified if the layout does not have both as numbers or starts with
+// the year, which leaves no doubt about the order.
+func layoutOrder(layout string) DateOrder {
+	d, m := strings.Index(layout, "%d"), strings.Index(layout, "%m")
+	y := strings.Index(layout, "%Y")
+	switch {
+	case d < 0 || m < 0 || (y >= 0 && y < d && y < m):
+		return OrderUnspecified
+	case d < m:
+		return DayFirst
+	}
+	return MonthFirst
+}
@@ -0,0 +1,99 @@
+/*
+MIT License
+
+Copyright (c) 2023 Mohammad Shafiee <muhammad.shafiee@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+
+package jalali
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestParseAny(t *testing.T) {
+	date := Date(1402, Mordad, 20, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		value  string
+		want   JalaliTime
+		layout string
+	}{
+		{"1402/5/20", date, "%Y/%m/%d%[ %H:%M%[:%S%]%]"},
+		{"1402-05-20", date, "%Y-%m-%d%[ %H:%M%[:%S%]%]"},
+		{"20/5/1402", date, "%d/%m/%Y%[ %H:%M%[:%S%]%]"},
+		{"5/20/1402", date, "%m/%d/%Y%[ %H:%M%[:%S%]%]"},
+		{"۱۴۰۲.۰۵.۲۰", date, "%Y.%m.%d%[ %H:%M%[:%S%]%]"},
+		{"20 Mordad 1402", date, "%d %B %Y%[ %H:%M%[:%S%]%]"},
+		{"  20 مرداد 1402 ", date, "%d %B %Y%[ %H:%M%[:%S%]%]"},
+		{"14020520", date, "%Y%m%d"},
+		{"1402/05/20 16:30", Date(1402, Mordad, 20, 16, 30, 0, 0, time.UTC), "%Y/%m/%d%[ %H:%M%[:%S%]%]"},
+		{"1402-05-20T16:30:45", Date(1402, Mordad, 20, 16, 30, 45, 0, time.UTC), "%Y-%m-%dT%H:%M%[:%S%]%[%z%]"},
+		{"05/05/1402", Date(1402, Mordad, 5, 0, 0, 0, 0, time.UTC), "%d/%m/%Y%[ %H:%M%[:%S%]%]"},
+	}
+
+	for _, tc := range tests {
+		got, layout, err := ParseAny(tc.value, time.UTC)
+		if err != nil {
+			t.Errorf("ParseAny(%q) error = %v", tc.value, err)
+			continue
+		}
+		if got != tc.want || layout != tc.layout {
+			t.Errorf("ParseAny(%q) = %v, %q, want %v, %q", tc.value, got, layout, tc.want, tc.layout)
+		}
+	}
+}
+
+func TestParseAnyAmbiguous(t *testing.T) {
+	if _, _, err := ParseAny("05/06/1402", time.UTC); !errors.Is(err, ErrAmbiguousDate) {
+		t.Errorf("ParseAny() error = %v, want ErrAmbiguousDate", err)
+	}
+
+	p := &AnyParser{Order: DayFirst}
+	got, layout, err := p.Parse("05/06/1402", time.UTC)
+	if err != nil || got != Date(1402, Shahrivar, 5, 0, 0, 0, 0, time.UTC) || layout != "%d/%m/%Y%[ %H:%M%[:%S%]%]" {
+		t.Errorf("Parse() with DayFirst = %v, %q, %v", got, layout, err)
+	}
+
+	p = &AnyParser{Order: MonthFirst}
+	got, layout, err = p.Parse("05-06-1402 10:00", time.UTC)
+	if err != nil || got != Date(1402, Mordad, 6, 10, 0, 0, 0, time.UTC) || layout != "%m-%d-%Y%[ %H:%M%[:%S%]%]" {
+		t.Errorf("Parse() with MonthFirst = %v, %q, %v", got, layout, err)
+	}
+}
+
+func TestParseAnyErrors(t *testing.T) {
+	if _, _, err := ParseAny("1402/12/30", time.UTC); !errors.Is(err, ErrInvalidDate) {
+		t.Errorf("ParseAny() error = %v, want ErrInvalidDate", err)
+	}
+
+	_, _, err := ParseAny("1402/05/20 16:3x", time.UTC)
+	var perr *ParseError
+	if !errors.As(err, &perr) || perr.Offset != 15 {
+		t.Errorf("ParseAny() error = %v, want *ParseError at offset 15", err)
+	}
+
+	p := &AnyParser{Layouts: []string{"%Y/%m/%d"}}
+	if _, _, err := p.Parse("1402-05-20", time.UTC); err == nil {
+		t.Errorf("Parse() accepted a value that matches none of its layouts")
+	}
+}
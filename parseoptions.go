@@ -0,0 +1,99 @@
+/*
+MIT License
+
+Copyright (c) 2023 Mohammad Shafiee <muhammad.shafiee@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+
+package jalali
+
+import (
+	"fmt"
+	"time"
+)
+
+// DefaultTwoDigitYearPivot is the two-digit year pivot used when none is set:
+// %y reads 00-79 as 1400-1479 and 80-99 as 1380-1399.
+const DefaultTwoDigitYearPivot = 80
+
+// ParseMode controls how closely a value must follow its layout.
+type ParseMode int
+
+const (
+	// ModeDefault accepts numbers with or without zero padding, and requires the
+	// rest of the value to match the layout exactly.
+	ModeDefault ParseMode = iota
+	// ModeStrict requires numbers to have exactly the width Format writes: four
+	// digits for %Y, nine for %f and two for the other numbers. Fractional
+	// seconds are only read by %f.
+	ModeStrict
+	// ModeLenient accepts numbers with or without zero padding, and any amount of
+	// white space around the value and around the literal text of the layout,
+	// where %n and spaces also match no white space at all.
+	ModeLenient
+)
+
+// ParseOptions configures Parse and ParseInLocation.
+type ParseOptions struct {
+	// TwoDigitYearPivot chooses the century of years read by %y: two-digit years
+	// from the pivot up to 99 are read as 1300+pivot to 1399, and years below
+	// the pivot as 1400 to 1400+pivot-1, counting in the era of the locale. It
+	// must be between 1 and 100; if zero, DefaultTwoDigitYearPivot is used.
+	TwoDigitYearPivot int
+
+	// Mode chooses strict, lenient or default parsing.
+	Mode ParseMode
+
+	// Locale is used to match names and read years. If nil, LocalePersian is used.
+	Locale *Locale
+}
+
+// Parse is like the package-level Parse, but uses the options.
+func (o ParseOptions) Parse(layout, value string) (JalaliTime, error) {
+	return o.ParseInLocation(layout, value, time.Local)
+}
+
+// ParseInLocation is like the package-level ParseInLocation, but uses the options.
+func (o ParseOptions) ParseInLocation(layout, value string, loc *time.Location) (JalaliTime, error) {
+	l := o.Locale
+	if l == nil {
+		l = LocalePersian
+	}
+	return o.parse(layout, splitLayout(layout), value, loc, l)
+}
+
+// ParseLayout is like Layout.Parse, but uses the options. The layout's locale
+// is used unless o.Locale is set.
+func (o ParseOptions) ParseLayout(c *Layout, value string, loc *time.Location) (JalaliTime, error) {
+	l := o.Locale
+	if l == nil {
+		l = c.locale
+	}
+	return o.parse(c.layout, c.elems, value, loc, l)
+}
+
+// parse parses value according to the layout elements elems with the options.
+func (o ParseOptions) parse(layout string, elems []layoutElem, value string, loc *time.Location, l *Locale) (JalaliTime, error) {
+	if o.TwoDigitYearPivot < 0 || o.TwoDigitYearPivot > 100 {
+		return JalaliTime{}, fmt.Errorf("two-digit year pivot out of range: %d", o.TwoDigitYearPivot)
+	}
+	p := &parser{layout: layout, elems: elems, value: value, locale: l, mode: o.Mode, pivot: o.TwoDigitYearPivot}
+	return p.run(loc)
+}
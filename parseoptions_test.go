@@ -0,0 +1,143 @@
+/*
+MIT License
+
+Copyright (c) 2023 Mohammad Shafiee <muhammad.shafiee@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+
+package jalali
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestParseTwoDigitYear(t *testing.T) {
+	tests := []struct {
+		pivot int
+		value string
+		want  int
+	}{
+		{0, "02/05/20", 1402},
+		{0, "79/05/20", 1479},
+		{0, "80/05/20", 1380},
+		{0, "99/05/20", 1399},
+		{50, "49/05/20", 1449},
+		{50, "50/05/20", 1350},
+		{100, "99/05/20", 1499},
+		{1, "00/05/20", 1400},
+		{1, "01/05/20", 1301},
+	}
+
+	for _, tc := range tests {
+		got, err := ParseOptions{TwoDigitYearPivot: tc.pivot}.ParseInLocation("%y/%m/%d", tc.value, time.UTC)
+		if err != nil || got.Year() != tc.want {
+			t.Errorf("ParseInLocation(%q) with pivot %d = %v, %v, want year %d", tc.value, tc.pivot, got, err, tc.want)
+		}
+	}
+
+	// Parse uses the default pivot, so %y round-trips recent years.
+	j := Date(1402, Mordad, 20, 0, 0, 0, 0, time.UTC)
+	if got, err := ParseInLocation("%y/%m/%d", j.Format("%y/%m/%d"), time.UTC); err != nil || got != j {
+		t.Errorf("ParseInLocation() = %v, %v, want %v", got, err, j)
+	}
+
+	// Two-digit years are counted in the era of the locale.
+	kurdish := ParseOptions{Locale: LocaleSorani}
+	if got, err := kurdish.ParseInLocation("%y/%m/%d", "23/05/20", time.UTC); err != nil || got.Year() != 1402 {
+		t.Errorf("ParseInLocation() in the Kurdish era = %v, %v, want year 1402", got, err)
+	}
+
+	if _, err := (ParseOptions{TwoDigitYearPivot: 101}).ParseInLocation("%y", "02", time.UTC); err == nil {
+		t.Error("ParseInLocation() with pivot 101 error = nil, want an error")
+	}
+}
+
+func TestParseStrict(t *testing.T) {
+	strict := ParseOptions{Mode: ModeStrict}
+
+	valid := []struct {
+		layout, value string
+		want          JalaliTime
+	}{
+		{"%Y/%m/%d", "1402/05/20", Date(1402, Mordad, 20, 0, 0, 0, 0, time.UTC)},
+		{"%Y/%m/%d %T", "1402/05/20 08:05:09", Date(1402, Mordad, 20, 8, 5, 9, 0, time.UTC)},
+		{"%T.%f", "08:05:09.500000000", Date(1, Farvardin, 1, 8, 5, 9, 500000000, time.UTC)},
+		{"%Y%m%d", "14020520", Date(1402, Mordad, 20, 0, 0, 0, 0, time.UTC)},
+	}
+	for _, tc := range valid {
+		got, err := strict.ParseInLocation(tc.layout, tc.value, time.UTC)
+		if err != nil || got != tc.want {
+			t.Errorf("strict ParseInLocation(%q, %q) = %v, %v, want %v", tc.layout, tc.value, got, err, tc.want)
+		}
+	}
+
+	invalid := []struct {
+		layout, value, elem string
+	}{
+		{"%Y/%m/%d", "1402/5/20", "%m"},
+		{"%Y/%m/%d", "402/05/20", "%Y"},
+		{"%Y/%m/%d", "1402/05/20 ", ""},
+		{"%H:%M", "8:05", "%H"},
+		{"%T", "08:05:09.5", ""},
+		{"%T.%f", "08:05:09.5", "%f"},
+	}
+	for _, tc := range invalid {
+		_, err := strict.ParseInLocation(tc.layout, tc.value, time.UTC)
+		var perr *ParseError
+		if !errors.As(err, &perr) || perr.LayoutElem != tc.elem {
+			t.Errorf("strict ParseInLocation(%q, %q) error = %v, want a ParseError at %q", tc.layout, tc.value, err, tc.elem)
+		}
+	}
+
+	// The default mode accepts the same values without padding.
+	if _, err := ParseInLocation("%Y/%m/%d", "1402/5/20", time.UTC); err != nil {
+		t.Errorf("ParseInLocation() error = %v", err)
+	}
+}
+
+func TestParseLenient(t *testing.T) {
+	lenient := ParseOptions{Mode: ModeLenient}
+	want := Date(1402, Mordad, 20, 16, 30, 0, 0, time.UTC)
+
+	for _, value := range []string{
+		"1402/05/20 16:30",
+		"  1402 / 5 / 20   16:30  ",
+		"1402/05/20\t16 : 30",
+		"1402/05/2016:30",
+		"۱۴۰۲/۵/۲۰ ۱۶:۳۰",
+	} {
+		got, err := lenient.ParseInLocation("%Y/%m/%d %H:%M", value, time.UTC)
+		if err != nil || got != want {
+			t.Errorf("lenient ParseInLocation(%q) = %v, %v, want %v", value, got, err, want)
+		}
+	}
+
+	if got, err := lenient.ParseInLocation("%d %B%n%Y", "20   مرداد \n 1402", time.UTC); err != nil || got.Day() != 20 {
+		t.Errorf("lenient ParseInLocation() with names = %v, %v", got, err)
+	}
+	if _, err := lenient.ParseInLocation("%Y/%m/%d", "1402-05-20", time.UTC); err == nil {
+		t.Error("lenient ParseInLocation() with other separators error = nil, want an error")
+	}
+	if _, err := ParseInLocation("%Y/%m/%d", "1402 / 05 / 20", time.UTC); err == nil {
+		t.Error("ParseInLocation() with extra white space error = nil, want an error")
+	}
+}
@@ -0,0 +1,189 @@
+/*
+MIT License
+
+Copyright (c) 2023 Mohammad Shafiee <muhammad.shafiee@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+
+package jalali
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// ErrInvalidTimestamp is returned for google.protobuf.Timestamp values that
+// are invalid or outside the supported range.
+var ErrInvalidTimestamp = errors.New("invalid protobuf timestamp")
+
+// ErrInvalidProtoDate is returned for google.type.Date values that are
+// invalid, partial or outside the supported range.
+var ErrInvalidProtoDate = errors.New("invalid protobuf date")
+
+// The supported range of protobuf values runs from the first day of the
+// Jalali year 979, the earliest date the calendar conversion supports, to the
+// last second of 9999-12-31, the end of the google.protobuf.Timestamp range.
+const (
+	minTimestampSeconds = -11669270400 // 1600-03-20T00:00:00Z, Farvardin 1, 979
+	maxTimestampSeconds = 253402300799 // 9999-12-31T23:59:59Z
+)
+
+// FromTimestamp returns the JalaliTime in loc of the fields of a
+// google.protobuf.Timestamp, without depending on the protobuf packages:
+//
+//	j, err := jalali.FromTimestamp(ts.GetSeconds(), ts.GetNanos(), jalali.Tehran())
+//
+// Negative seconds count back from the Unix epoch, with nanos still counting
+// forward, so -1.5s is (-2, 500000000). Nanos outside [0, 999999999] and
+// instants outside the supported range are errors.
+func FromTimestamp(seconds int64, nanos int32, loc *time.Location) (JalaliTime, error) {
+	if nanos < 0 || nanos > 999999999 {
+		return JalaliTime{}, fmt.Errorf("%w: nanos %d out of range [0, 999999999]", ErrInvalidTimestamp, nanos)
+	}
+	if seconds < minTimestampSeconds || seconds > maxTimestampSeconds {
+		return JalaliTime{}, fmt.Errorf("%w: seconds %d out of range", ErrInvalidTimestamp, seconds)
+	}
+	j := ToJalali(time.Unix(seconds, int64(nanos)).In(loc))
+	if !isValidJalaliDate(j.year, int(j.month), j.day) {
+		return JalaliTime{}, fmt.Errorf("%w: seconds %d out of range", ErrInvalidTimestamp, seconds)
+	}
+	return j, nil
+}
+
+// Timestamp returns j as the fields of a google.protobuf.Timestamp:
+//
+//	seconds, nanos, err := j.Timestamp()
+//	ts := &timestamppb.Timestamp{Seconds: seconds, Nanos: nanos}
+//
+// The zero JalaliTime and times outside the supported range are errors.
+func (j JalaliTime) Timestamp() (seconds int64, nanos int32, err error) {
+	if !isValidJalaliDate(j.year, int(j.month), j.day) {
+		return 0, 0, fmt.Errorf("%w: %v is not a valid time", ErrInvalidTimestamp, j)
+	}
+	t := j.ToTime()
+	if t.Unix() < minTimestampSeconds || t.Unix() > maxTimestampSeconds {
+		return 0, 0, fmt.Errorf("%w: %v out of range", ErrInvalidTimestamp, j)
+	}
+	return t.Unix(), int32(t.Nanosecond()), nil
+}
+
+// TimestampJSON returns j in the protobuf JSON mapping of
+// google.protobuf.Timestamp: a quoted RFC 3339 Gregorian time in UTC with 0,
+// 3, 6 or 9 fractional digits, such as "2023-08-11T13:00:00.500Z".
+func (j JalaliTime) TimestampJSON() ([]byte, error) {
+	seconds, nanos, err := j.Timestamp()
+	if err != nil {
+		return nil, err
+	}
+	b := make([]byte, 0, len(`"2006-01-02T15:04:05.000000000Z"`))
+	b = append(b, '"')
+	b = time.Unix(seconds, 0).UTC().AppendFormat(b, "2006-01-02T15:04:05")
+	switch {
+	case nanos == 0:
+	case nanos%1000000 == 0:
+		b = append(b, '.')
+		b = appendInt(b, int(nanos/1000000), 3)
+	case nanos%1000 == 0:
+		b = append(b, '.')
+		b = appendInt(b, int(nanos/1000), 6)
+	default:
+		b = append(b, '.')
+		b = appendInt(b, int(nanos), 9)
+	}
+	return append(b, 'Z', '"'), nil
+}
+
+// TimestampFromJSON parses a google.protobuf.Timestamp in the protobuf JSON
+// mapping, a quoted RFC 3339 Gregorian time, and returns it in loc.
+func TimestampFromJSON(data []byte, loc *time.Location) (JalaliTime, error) {
+	s, err := strconv.Unquote(string(data))
+	if err != nil {
+		return JalaliTime{}, fmt.Errorf("%w: expected a JSON string, got %s", ErrInvalidTimestamp, data)
+	}
+	t, err := time.Parse(time.RFC3339Nano, s)
+	if err != nil {
+		return JalaliTime{}, fmt.Errorf("%w: %v", ErrInvalidTimestamp, err)
+	}
+	return FromTimestamp(t.Unix(), int32(t.Nanosecond()), loc)
+}
+
+// FromProtoDate returns the JalaliDate of the fields of a google.type.Date,
+// which holds a Gregorian date, without depending on the protobuf packages:
+//
+//	d, err := jalali.FromProtoDate(date.GetYear(), date.GetMonth(), date.GetDay())
+//
+// Partial dates, whose year, month or day is 0, have no Jalali equivalent and
+// are errors, as are dates outside the supported range.
+func FromProtoDate(year, month, day int32) (JalaliDate, error) {
+	if year == 0 || month == 0 || day == 0 {
+		return JalaliDate{}, fmt.Errorf("%w: partial date %04d-%02d-%02d", ErrInvalidProtoDate, year, month, day)
+	}
+	if !isValidGregorianDate(int(year), int(month), int(day)) {
+		return JalaliDate{}, fmt.Errorf("%w: %04d-%02d-%02d", ErrInvalidProtoDate, year, month, day)
+	}
+	t := time.Date(int(year), time.Month(month), int(day), 0, 0, 0, 0, time.UTC)
+	if t.Unix() < minTimestampSeconds || t.Unix() > maxTimestampSeconds {
+		return JalaliDate{}, fmt.Errorf("%w: %04d-%02d-%02d out of range", ErrInvalidProtoDate, year, month, day)
+	}
+	return DateOf(t), nil
+}
+
+// ProtoDate returns d as the fields of a google.type.Date, which holds a
+// Gregorian date. Invalid dates and dates outside the supported range are
+// errors.
+func (d JalaliDate) ProtoDate() (year, month, day int32, err error) {
+	if !d.IsValid() {
+		return 0, 0, 0, fmt.Errorf("%w: %v is not a valid date", ErrInvalidProtoDate, d)
+	}
+	t := d.In(time.UTC).ToTime()
+	if t.Unix() < minTimestampSeconds || t.Unix() > maxTimestampSeconds {
+		return 0, 0, 0, fmt.Errorf("%w: %v out of range", ErrInvalidProtoDate, d)
+	}
+	return int32(t.Year()), int32(t.Month()), int32(t.Day()), nil
+}
+
+// protoDateJSON is the protobuf JSON mapping of google.type.Date.
+type protoDateJSON struct {
+	Year  int32 `json:"year,omitempty"`
+	Month int32 `json:"month,omitempty"`
+	Day   int32 `json:"day,omitempty"`
+}
+
+// ProtoDateJSON returns d in the protobuf JSON mapping of google.type.Date,
+// an object of Gregorian fields such as {"year":2023,"month":8,"day":11}.
+func (d JalaliDate) ProtoDateJSON() ([]byte, error) {
+	year, month, day, err := d.ProtoDate()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(protoDateJSON{year, month, day})
+}
+
+// ProtoDateFromJSON parses a google.type.Date in the protobuf JSON mapping.
+func ProtoDateFromJSON(data []byte) (JalaliDate, error) {
+	var v protoDateJSON
+	if err := json.Unmarshal(data, &v); err != nil {
+		return JalaliDate{}, fmt.Errorf("%w: %v", ErrInvalidProtoDate, err)
+	}
+	return FromProtoDate(v.Year, v.Month, v.Day)
+}
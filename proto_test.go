@@ -0,0 +1,149 @@
+/*
+MIT License
+
+Copyright (c) 2023 Mohammad Shafiee <muhammad.shafiee@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+
+package jalali
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestTimestamp(t *testing.T) {
+	tehran := time.FixedZone("IRST", 12600)
+
+	tests := []struct {
+		seconds int64
+		nanos   int32
+		want    JalaliTime
+	}{
+		{0, 0, Date(1348, Dey, 11, 3, 30, 0, 0, tehran)},
+		{1691760600, 500000000, Date(1402, Mordad, 20, 17, 0, 0, 500000000, tehran)},
+		// -1.5s is one and a half seconds before the epoch.
+		{-2, 500000000, Date(1348, Dey, 11, 3, 29, 58, 500000000, tehran)},
+		{-11669270400, 0, Date(979, Farvardin, 1, 3, 30, 0, 0, tehran)},
+		{253402300799, 999999999, Date(9378, Dey, 11, 3, 29, 59, 999999999, tehran)},
+	}
+	for _, tc := range tests {
+		got, err := FromTimestamp(tc.seconds, tc.nanos, tehran)
+		if err != nil || got != tc.want {
+			t.Errorf("FromTimestamp(%d, %d) = %v, %v, want %v", tc.seconds, tc.nanos, got, err, tc.want)
+			continue
+		}
+		seconds, nanos, err := got.Timestamp()
+		if err != nil || seconds != tc.seconds || nanos != tc.nanos {
+			t.Errorf("%v.Timestamp() = %d, %d, %v, want %d, %d", got, seconds, nanos, err, tc.seconds, tc.nanos)
+		}
+	}
+}
+
+func TestTimestampErrors(t *testing.T) {
+	tests := []struct {
+		seconds int64
+		nanos   int32
+	}{
+		{0, -1},
+		{0, 1000000000},
+		{-1, -500000000},
+		{253402300800, 0},
+		{-11669270401, 0},
+		{-62135596800, 0},
+	}
+	for _, tc := range tests {
+		if _, err := FromTimestamp(tc.seconds, tc.nanos, time.UTC); !errors.Is(err, ErrInvalidTimestamp) {
+			t.Errorf("FromTimestamp(%d, %d) error = %v, want ErrInvalidTimestamp", tc.seconds, tc.nanos, err)
+		}
+	}
+
+	for _, j := range []JalaliTime{{}, Date(9400, Farvardin, 1, 0, 0, 0, 0, time.UTC)} {
+		if _, _, err := j.Timestamp(); !errors.Is(err, ErrInvalidTimestamp) {
+			t.Errorf("%v.Timestamp() error = %v, want ErrInvalidTimestamp", j, err)
+		}
+	}
+}
+
+func TestTimestampJSON(t *testing.T) {
+	tests := []struct {
+		j    JalaliTime
+		want string
+	}{
+		{Date(1402, Mordad, 20, 16, 30, 0, 0, time.FixedZone("IRST", 12600)), `"2023-08-11T13:00:00Z"`},
+		{Date(1402, Mordad, 20, 13, 0, 0, 500000000, time.UTC), `"2023-08-11T13:00:00.500Z"`},
+		{Date(1402, Mordad, 20, 13, 0, 0, 1000, time.UTC), `"2023-08-11T13:00:00.000001Z"`},
+		{Date(1402, Mordad, 20, 13, 0, 0, 1, time.UTC), `"2023-08-11T13:00:00.000000001Z"`},
+		{Date(1348, Dey, 11, 0, 0, 0, 0, time.UTC), `"1970-01-01T00:00:00Z"`},
+	}
+	for _, tc := range tests {
+		data, err := tc.j.TimestampJSON()
+		if err != nil || string(data) != tc.want {
+			t.Errorf("%v.TimestampJSON() = %s, %v, want %s", tc.j, data, err, tc.want)
+			continue
+		}
+		got, err := TimestampFromJSON(data, tc.j.Location())
+		if err != nil || !sameInstant(got, tc.j) {
+			t.Errorf("TimestampFromJSON(%s) = %v, %v, want %v", data, got, err, tc.j)
+		}
+	}
+
+	got, err := TimestampFromJSON([]byte(`"2023-08-11T16:30:00+03:30"`), time.UTC)
+	if err != nil || got != Date(1402, Mordad, 20, 13, 0, 0, 0, time.UTC) {
+		t.Errorf("TimestampFromJSON() with an offset = %v, %v", got, err)
+	}
+	for _, data := range []string{`1691760600`, `"2023-08-11"`, `"0001-01-01T00:00:00Z"`} {
+		if _, err := TimestampFromJSON([]byte(data), time.UTC); !errors.Is(err, ErrInvalidTimestamp) {
+			t.Errorf("TimestampFromJSON(%s) error = %v, want ErrInvalidTimestamp", data, err)
+		}
+	}
+}
+
+func TestProtoDate(t *testing.T) {
+	d, err := FromProtoDate(2023, 8, 11)
+	if err != nil || d != (JalaliDate{1402, Mordad, 20}) {
+		t.Errorf("FromProtoDate() = %v, %v", d, err)
+	}
+	year, month, day, err := d.ProtoDate()
+	if err != nil || year != 2023 || month != 8 || day != 11 {
+		t.Errorf("ProtoDate() = %d, %d, %d, %v", year, month, day, err)
+	}
+
+	data, err := d.ProtoDateJSON()
+	if err != nil || string(data) != `{"year":2023,"month":8,"day":11}` {
+		t.Errorf("ProtoDateJSON() = %s, %v", data, err)
+	}
+	if got, err := ProtoDateFromJSON(data); err != nil || got != d {
+		t.Errorf("ProtoDateFromJSON() = %v, %v", got, err)
+	}
+
+	for _, v := range [][3]int32{{2023, 8, 0}, {0, 8, 11}, {2023, 2, 29}, {2023, 13, 1}, {1500, 1, 1}} {
+		if _, err := FromProtoDate(v[0], v[1], v[2]); !errors.Is(err, ErrInvalidProtoDate) {
+			t.Errorf("FromProtoDate(%v) error = %v, want ErrInvalidProtoDate", v, err)
+		}
+	}
+	if _, _, _, err := (JalaliDate{1402, Esfand, 30}).ProtoDate(); !errors.Is(err, ErrInvalidProtoDate) {
+		t.Errorf("ProtoDate() error = %v, want ErrInvalidProtoDate", err)
+	}
+	if _, err := ProtoDateFromJSON([]byte(`{"year":2023,"month":8}`)); !errors.Is(err, ErrInvalidProtoDate) {
+		t.Errorf("ProtoDateFromJSON() error = %v, want ErrInvalidProtoDate", err)
+	}
+}
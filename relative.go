@@ -0,0 +1,494 @@
+/*
+MIT License
+
+Copyright (c) 2023 Mohammad Shafiee <muhammad.shafiee@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+
+package jalali
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+)
+
+// ErrRelativeDate is returned, wrapped, by ParseRelative for expressions it does not understand.
+var ErrRelativeDate = errors.New("unrecognized relative date expression")
+
+// Interval is the span of time from Start up to, but not including, End. An
+// interval that denotes a single instant has Start equal to End.
+type Interval struct {
+	Start JalaliTime
+	End   JalaliTime
+}
+
+// IsInstant reports whether the interval denotes a single instant.
+func (i Interval) IsInstant() bool {
+	return i.Start.Equal(i.End)
+}
+
+// Contains reports whether t lies within the interval. An instant contains only itself.
+func (i Interval) Contains(t JalaliTime) bool {
+	if i.IsInstant() {
+		return t.Equal(i.Start)
+	}
+	return !t.Before(i.Start) && t.Before(i.End)
+}
+
+// relKind is the kind of a word of a relative date expression.
+type relKind int
+
+const (
+	relDay     relKind = iota + 1 // a day relative to today, such as "فردا"
+	relNumber                     // a count
+	relUnit                       // a unit of time
+	relDir                        // a direction: -1 for the past, 0 for the present, 1 for the future
+	relWeekday                    // a weekday name
+	relMonth                      // a month name
+	relStart                      // the first day of a period, such as "اول"
+	relEnd                        // the last day of a period, such as "آخر"
+	relNowruz                     // the first day of the year
+	relIn                         // "in", as in "in 3 days"
+)
+
+// relToken is a word of a relative date expression.
+type relToken struct {
+	kind relKind
+	n    int
+}
+
+// relWords maps the words and phrases of relative date expressions, in lower
+// case with single spaces, to their tokens. Words that map to no tokens are ignored.
+var relWords = map[string][]relToken{
+	"امروز":                {{relDay, 0}},
+	"فردا":                 {{relDay, 1}},
+	"پس فردا":              {{relDay, 2}},
+	"پسفردا":               {{relDay, 2}},
+	"دیروز":                {{relDay, -1}},
+	"پریروز":               {{relDay, -2}},
+	"today":                {{relDay, 0}},
+	"tomorrow":             {{relDay, 1}},
+	"day after tomorrow":   {{relDay, 2}},
+	"yesterday":            {{relDay, -1}},
+	"day before yesterday": {{relDay, -2}},
+
+	"دقیقه":   {{relUnit, unitMinute}},
+	"ساعت":    {{relUnit, unitHour}},
+	"روز":     {{relUnit, unitDay}},
+	"هفته":    {{relUnit, unitWeek}},
+	"ماه":     {{relUnit, unitMonth}},
+	"سال":     {{relUnit, unitYear}},
+	"minute":  {{relUnit, unitMinute}},
+	"minutes": {{relUnit, unitMinute}},
+	"hour":    {{relUnit, unitHour}},
+	"hours":   {{relUnit, unitHour}},
+	"day":     {{relUnit, unitDay}},
+	"days":    {{relUnit, unitDay}},
+	"week":    {{relUnit, unitWeek}},
+	"weeks":   {{relUnit, unitWeek}},
+	"month":   {{relUnit, unitMonth}},
+	"months":  {{relUnit, unitMonth}},
+	"year":    {{relUnit, unitYear}},
+	"years":   {{relUnit, unitYear}},
+
+	"دیگر":     {{relDir, 1}},
+	"بعد":      {{relDir, 1}},
+	"بعدی":     {{relDir, 1}},
+	"آینده":    {{relDir, 1}},
+	"اینده":    {{relDir, 1}},
+	"پیش":      {{relDir, -1}},
+	"قبل":      {{relDir, -1}},
+	"قبلی":     {{relDir, -1}},
+	"گذشته":    {{relDir, -1}},
+	"این":      {{relDir, 0}},
+	"جاری":     {{relDir, 0}},
+	"next":     {{relDir, 1}},
+	"later":    {{relDir, 1}},
+	"from now": {{relDir, 1}},
+	"last":     {{relDir, -1}},
+	"previous": {{relDir, -1}},
+	"ago":      {{relDir, -1}},
+	"this":     {{relDir, 0}},
+	"current":  {{relDir, 0}},
+	"امسال":    {{relUnit, unitYear}, {relDir, 0}},
+	"پارسال":   {{relUnit, unitYear}, {relDir, -1}},
+
+	"اول":          {{relStart, 0}},
+	"ابتدای":       {{relStart, 0}},
+	"start of":     {{relStart, 0}},
+	"beginning of": {{relStart, 0}},
+	"first of":     {{relStart, 0}},
+	"first day of": {{relStart, 0}},
+	"آخر":          {{relEnd, 0}},
+	"اخر":          {{relEnd, 0}},
+	"پایان":        {{relEnd, 0}},
+	"انتهای":       {{relEnd, 0}},
+	"end of":       {{relEnd, 0}},
+	"last day of":  {{relEnd, 0}},
+	"نوروز":        {{relNowruz, 0}},
+	"عید نوروز":    {{relNowruz, 0}},
+	"nowruz":       {{relNowruz, 0}},
+	"norouz":       {{relNowruz, 0}},
+	"noruz":        {{relNowruz, 0}},
+	"in":           {{relIn, 0}},
+	"a":            {{relNumber, 1}},
+	"an":           {{relNumber, 1}},
+	"one":          {{relNumber, 1}},
+	"two":          {{relNumber, 2}},
+	"three":        {{relNumber, 3}},
+	"four":         {{relNumber, 4}},
+	"five":         {{relNumber, 5}},
+	"six":          {{relNumber, 6}},
+	"seven":        {{relNumber, 7}},
+	"eight":        {{relNumber, 8}},
+	"nine":         {{relNumber, 9}},
+	"ten":          {{relNumber, 10}},
+	"the":          nil,
+	"on":           nil,
+	"of":           nil,
+	"در":           nil,
+}
+
+// ParseRelative parses a date expression relative to now, in Persian or
+// English, and returns the instant or the span of time it denotes:
+//
+//   - days: "امروز", "فردا", "پس‌فردا", "دیروز", "today", "tomorrow", "day after tomorrow";
+//   - offsets: "۳ روز دیگر", "سه هفته پیش", "۲ ساعت بعد", "in 3 days", "2 weeks ago";
+//   - weekdays: "شنبه", "شنبه بعد", "هفته بعد شنبه", "saturday next week";
+//   - periods: "هفته بعد", "ماه آینده", "امسال", "مهر", "next month", "last year";
+//   - their first and last days: "اول ماه آینده", "آخر اسفند", "end of next month";
+//   - dates: "۱۵ مهر", "15 Mehr next year";
+//   - the new year: "نوروز", "نوروز سال بعد", "nowruz next year";
+//   - compact offsets: "-7d", "+2w", "3m", with the units h, d, w, m (months) and y.
+//
+// Days, offsets, weekdays and dates are instants that keep the clock time of
+// now; periods are intervals from midnight of their first day to midnight of
+// the day after their last. Weeks start on Saturday. A month named without a
+// year is the next such month, counting the current one, and "نوروز" without a
+// year is the next Nowruz, counting today. Numbers may be written in ASCII,
+// Persian or Arabic-Indic digits or in Persian words, and names are matched as
+// by Parse.
+func ParseRelative(expr string, now JalaliTime) (Interval, error) {
+	var (
+		i  Interval
+		ok bool
+	)
+	if n, unit, compact := relCompact(expr); compact {
+		i, ok = relOffset(now, n, unit)
+	} else {
+		tokens, err := relTokens(expr)
+		if err != nil {
+			return Interval{}, err
+		}
+		i, ok = relResolve(tokens, now)
+	}
+	if !ok {
+		return Interval{}, fmt.Errorf("%w: %q", ErrRelativeDate, expr)
+	}
+	if !isValidJalaliDate(i.Start.year, int(i.Start.month), i.Start.day) || i.Start.year > 9999 ||
+		!isValidJalaliDate(i.End.year, int(i.End.month), i.End.day) || i.End.year > 9999 {
+		return Interval{}, fmt.Errorf("%w: %q is out of range", ErrRelativeDate, expr)
+	}
+	return i, nil
+}
+
+// relTokens splits expr into tokens, matching the longest word or phrase at
+// each position.
+func relTokens(expr string) ([]relToken, error) {
+	value := strings.ToLower(Normalize(expr))
+	value = strings.Join(strings.Fields(strings.ReplaceAll(value, string(zwnj), " ")), " ")
+
+	var tokens []relToken
+	for value != "" {
+		var (
+			best    []relToken
+			bestLen int
+		)
+		for phrase, t := range relWords {
+			if len(phrase) > bestLen && strings.HasPrefix(value, phrase) && atWordEnd(value[len(phrase):]) {
+				best, bestLen = t, len(phrase)
+			}
+		}
+		if w, rest, err := lookupName(value, weekdayAliases); err == nil && atWordEnd(rest) && len(value)-len(rest) > bestLen {
+			best, bestLen = []relToken{{relWeekday, w}}, len(value)-len(rest)
+		}
+		if m, rest, err := lookupName(value, monthAliases); err == nil && atWordEnd(rest) && len(value)-len(rest) > bestLen {
+			best, bestLen = []relToken{{relMonth, m}}, len(value)-len(rest)
+		}
+
+		if bestLen == 0 {
+			n, rest, err := getNum(value, 9)
+			if err != nil {
+				n, rest, err = getPersianWords(value)
+			}
+			if err != nil || !atWordEnd(rest) {
+				word, _, _ := strings.Cut(value, " ")
+				return nil, fmt.Errorf("%w %q: unknown word %q", ErrRelativeDate, expr, word)
+			}
+			best, bestLen = []relToken{{relNumber, n}}, len(value)-len(rest)
+		}
+
+		tokens = append(tokens, best...)
+		value = strings.TrimPrefix(value[bestLen:], " ")
+	}
+	return tokens, nil
+}
+
+// relCompactUnits maps the unit letters of compact offsets to units.
+var relCompactUnits = map[byte]int{'h': unitHour, 'd': unitDay, 'w': unitWeek, 'm': unitMonth, 'y': unitYear}
+
+// relCompact parses a compact offset such as "-7d" into a count and a unit.
+func relCompact(expr string) (n, unit int, ok bool) {
+	value := strings.TrimSpace(expr)
+	sign := 1
+	if strings.HasPrefix(value, "-") {
+		sign = -1
+		value = value[1:]
+	} else {
+		value = strings.TrimPrefix(value, "+")
+	}
+	n, rest, err := getNum(value, 9)
+	if err != nil || len(rest) != 1 {
+		return 0, 0, false
+	}
+	unit, ok = relCompactUnits[rest[0]|0x20]
+	return sign * n, unit, ok
+}
+
+// atWordEnd reports whether rest, the value after a match, starts at a word boundary.
+func atWordEnd(rest string) bool {
+	return rest == "" || rest[0] == ' '
+}
+
+// relResolve returns the instant or interval denoted by tokens, relative to now.
+func relResolve(tokens []relToken, now JalaliTime) (Interval, bool) {
+	if len(tokens) == 0 {
+		return Interval{}, false
+	}
+
+	switch first := tokens[0]; first.kind {
+	case relDay:
+		if len(tokens) == 1 {
+			return instant(now.AddDays(first.n)), true
+		}
+	case relIn:
+		// "in 3 days" points to the future.
+		if len(tokens) == 3 && tokens[1].kind == relNumber && tokens[2].kind == relUnit {
+			return relOffset(now, tokens[1].n, tokens[2].n)
+		}
+	case relNumber:
+		if len(tokens) == 3 && tokens[1].kind == relUnit && tokens[2].kind == relDir && tokens[2].n != 0 {
+			return relOffset(now, tokens[2].n*first.n, tokens[1].n)
+		}
+		if len(tokens) >= 2 && tokens[1].kind == relMonth {
+			year, ok := relYear(tokens[2:], now, now.year+boolToInt(Month(tokens[1].n) < now.month))
+			if !ok {
+				return Interval{}, false
+			}
+			return relDate(year, Month(tokens[1].n), first.n, now)
+		}
+	case relStart, relEnd:
+		period, unit, ok := relPeriod(tokens[1:], now)
+		if !ok || unit < unitDay {
+			return Interval{}, false
+		}
+		day := period.Start
+		if first.kind == relEnd {
+			day = period.End.AddDays(-1)
+		}
+		return relDate(day.year, day.month, day.day, now)
+	case relNowruz:
+		year, ok := relYear(tokens[1:], now, now.year+boolToInt(now.month != Farvardin || now.day != 1))
+		if !ok {
+			return Interval{}, false
+		}
+		return relDate(year, Farvardin, 1, now)
+	case relWeekday:
+		return relWeekdayIn(Weekday(first.n), tokens[1:], now)
+	}
+
+	// "شنبه بعد" and "next saturday" name the weekday after or before today.
+	if len(tokens) == 2 && tokens[0].kind == relDir && tokens[1].kind == relWeekday {
+		return relWeekdayIn(Weekday(tokens[1].n), tokens[:1], now)
+	}
+	// "هفته بعد شنبه" names a weekday in a week.
+	if last := tokens[len(tokens)-1]; last.kind == relWeekday {
+		return relWeekdayIn(Weekday(last.n), tokens[:len(tokens)-1], now)
+	}
+
+	period, unit, ok := relPeriod(tokens, now)
+	if !ok || unit < unitDay {
+		return Interval{}, false
+	}
+	return period, true
+}
+
+// relOffset returns the instant n units after now.
+func relOffset(now JalaliTime, n, unit int) (Interval, bool) {
+	switch unit {
+	case unitMinute, unitHour:
+		d := time.Minute
+		if unit == unitHour {
+			d = time.Hour
+		}
+		// Offsets beyond the range of time.Duration would overflow.
+		if n > int(math.MaxInt64/d) || n < int(math.MinInt64/d) {
+			return Interval{}, false
+		}
+		return instant(now.Add(time.Duration(n) * d)), true
+	case unitDay:
+		return instant(now.AddDays(n)), true
+	case unitWeek:
+		return instant(now.AddDays(7 * n)), true
+	case unitMonth:
+		year, month := shiftMonth(now.year, now.month, n)
+		day := now.day
+		if year >= 1 && day > daysInMonth(year, month) {
+			day = daysInMonth(year, month)
+		}
+		return relDate(year, month, day, now)
+	case unitYear:
+		day := now.day
+		if now.month == Esfand && day == 30 && !isLeapJalaliYear(now.year+n) {
+			day = 29
+		}
+		return relDate(now.year+n, now.month, day, now)
+	}
+	return Interval{}, false
+}
+
+// relPeriod returns the period denoted by tokens, which is a unit with an
+// optional direction, such as "ماه آینده" or "next month", or a month name
+// with an optional year, such as "اسفند سال بعد", and the unit of the period.
+func relPeriod(tokens []relToken, now JalaliTime) (Interval, int, bool) {
+	if len(tokens) >= 1 && tokens[0].kind == relMonth {
+		month := Month(tokens[0].n)
+		year, ok := relYear(tokens[1:], now, now.year+boolToInt(month < now.month))
+		if !ok {
+			return Interval{}, 0, false
+		}
+		nextYear, nextMonth := shiftMonth(year, month, 1)
+		start, end := midnight(year, month, 1, now.loc), midnight(nextYear, nextMonth, 1, now.loc)
+		return Interval{start, end}, unitMonth, true
+	}
+
+	unit, dir, ok := relUnitDir(tokens)
+	if !ok {
+		return Interval{}, 0, false
+	}
+	today := midnight(now.year, now.month, now.day, now.loc)
+	switch unit {
+	case unitDay:
+		start := today.AddDays(dir)
+		return Interval{start, start.AddDays(1)}, unit, true
+	case unitWeek:
+		start := today.AddDays(7*dir - (int(now.Weekday())+1)%7)
+		return Interval{start, start.AddDays(7)}, unit, true
+	case unitMonth:
+		year, month := shiftMonth(now.year, now.month, dir)
+		nextYear, nextMonth := shiftMonth(now.year, now.month, dir+1)
+		return Interval{midnight(year, month, 1, now.loc), midnight(nextYear, nextMonth, 1, now.loc)}, unit, true
+	case unitYear:
+		return Interval{midnight(now.year+dir, Farvardin, 1, now.loc), midnight(now.year+dir+1, Farvardin, 1, now.loc)}, unit, true
+	}
+	return Interval{}, unit, true
+}
+
+// relUnitDir reads a unit with an optional direction before or after it.
+func relUnitDir(tokens []relToken) (unit, dir int, ok bool) {
+	switch {
+	case len(tokens) == 1 && tokens[0].kind == relUnit:
+		return tokens[0].n, 0, true
+	case len(tokens) == 2 && tokens[0].kind == relUnit && tokens[1].kind == relDir:
+		return tokens[0].n, tokens[1].n, true
+	case len(tokens) == 2 && tokens[0].kind == relDir && tokens[1].kind == relUnit:
+		return tokens[1].n, tokens[0].n, true
+	}
+	return 0, 0, false
+}
+
+// relYear reads an optional year, such as "سال بعد" or "next year", and
+// returns it, or def if tokens is empty.
+func relYear(tokens []relToken, now JalaliTime, def int) (int, bool) {
+	if len(tokens) == 0 {
+		return def, true
+	}
+	unit, dir, ok := relUnitDir(tokens)
+	if !ok || unit != unitYear {
+		return 0, false
+	}
+	return now.year + dir, true
+}
+
+// relWeekdayIn returns the weekday w in the week denoted by tokens: the next w
+// on or after today without tokens, the next or previous w for a direction
+// alone, and w in a week such as "هفته بعد" otherwise.
+func relWeekdayIn(w Weekday, tokens []relToken, now JalaliTime) (Interval, bool) {
+	days := (int(w) - int(now.Weekday()) + 7) % 7
+	switch {
+	case len(tokens) == 0:
+		return instant(now.AddDays(days)), true
+	case len(tokens) == 1 && tokens[0].kind == relDir && tokens[0].n > 0:
+		if days == 0 {
+			days = 7
+		}
+		return instant(now.AddDays(days)), true
+	case len(tokens) == 1 && tokens[0].kind == relDir && tokens[0].n < 0:
+		return instant(now.AddDays(days - 7)), true
+	case len(tokens) == 1 && tokens[0].kind == relDir:
+		tokens = []relToken{{relUnit, unitWeek}, tokens[0]}
+	}
+
+	unit, dir, ok := relUnitDir(tokens)
+	if !ok || unit != unitWeek {
+		return Interval{}, false
+	}
+	// Days from the Saturday starting the current week to w and to today.
+	return instant(now.AddDays(7*dir + (int(w)+1)%7 - (int(now.Weekday())+1)%7)), true
+}
+
+// relDate returns the instant at the clock time of now on the given day, or
+// false if the day does not exist.
+func relDate(year int, month Month, day int, now JalaliTime) (Interval, bool) {
+	if year < 1 || year > 9999 || !isValidJalaliDate(year, int(month), day) {
+		return Interval{}, false
+	}
+	return instant(Date(year, month, day, now.hour, now.min, now.sec, now.nsec, now.loc)), true
+}
+
+// midnight returns the start of the given day. Unlike Date, it does not check
+// the day, which ParseRelative does before returning.
+func midnight(year int, month Month, day int, loc *time.Location) JalaliTime {
+	return JalaliTime{year: year, month: month, day: day, loc: loc}
+}
+
+// instant returns the interval holding only t.
+func instant(t JalaliTime) Interval {
+	return Interval{t, t}
+}
+
+// shiftMonth returns the year and month n months after month of year.
+func shiftMonth(year int, month Month, n int) (int, Month) {
+	m := int(month) - 1 + n
+	return year + floorDiv(m, 12), Month(m - 12*floorDiv(m, 12) + 1)
+}
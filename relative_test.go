@@ -0,0 +1,158 @@
+/*
+MIT License
+
+Copyright (c) 2023 Mohammad Shafiee <muhammad.shafiee@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+
+package jalali
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestParseRelativeInstant(t *testing.T) {
+	// Friday, 20 Mordad 1402.
+	now := Date(1402, Mordad, 20, 10, 30, 0, 0, time.UTC)
+	at := func(year int, month Month, day, hour, min int) JalaliTime {
+		return Date(year, month, day, hour, min, 0, 0, time.UTC)
+	}
+
+	tests := []struct {
+		expr string
+		want JalaliTime
+	}{
+		{"امروز", now},
+		{"فردا", at(1402, Mordad, 21, 10, 30)},
+		{"پس‌فردا", at(1402, Mordad, 22, 10, 30)},
+		{"پس فردا", at(1402, Mordad, 22, 10, 30)},
+		{"دیروز", at(1402, Mordad, 19, 10, 30)},
+		{"today", now},
+		{"Tomorrow", at(1402, Mordad, 21, 10, 30)},
+		{"the day after tomorrow", at(1402, Mordad, 22, 10, 30)},
+		{"yesterday", at(1402, Mordad, 19, 10, 30)},
+		{"۳ روز دیگر", at(1402, Mordad, 23, 10, 30)},
+		{"سه روز دیگر", at(1402, Mordad, 23, 10, 30)},
+		{"3 روز بعد", at(1402, Mordad, 23, 10, 30)},
+		{"in 3 days", at(1402, Mordad, 23, 10, 30)},
+		{"3 days ago", at(1402, Mordad, 17, 10, 30)},
+		{"a week ago", at(1402, Mordad, 13, 10, 30)},
+		{"۲ ساعت دیگر", at(1402, Mordad, 20, 12, 30)},
+		{"۱ ماه دیگر", at(1402, Shahrivar, 20, 10, 30)},
+		{"-7d", at(1402, Mordad, 13, 10, 30)},
+		{"+2w", at(1402, Shahrivar, 3, 10, 30)},
+		{"3M", at(1402, Aban, 20, 10, 30)},
+		{"-1y", at(1401, Mordad, 20, 10, 30)},
+		{"۲h", at(1402, Mordad, 20, 12, 30)},
+		{"2 months ago", at(1402, Khordad, 20, 10, 30)},
+		{"یک سال پیش", at(1401, Mordad, 20, 10, 30)},
+		{"شنبه", at(1402, Mordad, 21, 10, 30)},
+		{"جمعه", now},
+		{"جمعه بعد", at(1402, Mordad, 27, 10, 30)},
+		{"next friday", at(1402, Mordad, 27, 10, 30)},
+		{"last friday", at(1402, Mordad, 13, 10, 30)},
+		{"هفته بعد شنبه", at(1402, Mordad, 21, 10, 30)},
+		{"هفته بعد جمعه", at(1402, Mordad, 27, 10, 30)},
+		{"سه‌شنبه هفته بعد", at(1402, Mordad, 24, 10, 30)},
+		{"saturday next week", at(1402, Mordad, 21, 10, 30)},
+		{"on Monday last week", at(1402, Mordad, 9, 10, 30)},
+		{"اول ماه آینده", at(1402, Shahrivar, 1, 10, 30)},
+		{"start of next month", at(1402, Shahrivar, 1, 10, 30)},
+		{"آخر ماه", at(1402, Mordad, 31, 10, 30)},
+		{"end of next month", at(1402, Shahrivar, 31, 10, 30)},
+		{"آخر اسفند", at(1402, Esfand, 29, 10, 30)},
+		{"آخر اسفند سال بعد", at(1403, Esfand, 30, 10, 30)},
+		{"end of Esfand", at(1402, Esfand, 29, 10, 30)},
+		{"اول مهر", at(1402, Mehr, 1, 10, 30)},
+		{"۱۵ مهر", at(1402, Mehr, 15, 10, 30)},
+		{"15 Farvardin", at(1403, Farvardin, 15, 10, 30)},
+		{"نوروز", at(1403, Farvardin, 1, 10, 30)},
+		{"نوروز سال بعد", at(1403, Farvardin, 1, 10, 30)},
+		{"نوروز امسال", at(1402, Farvardin, 1, 10, 30)},
+		{"Nowruz next year", at(1403, Farvardin, 1, 10, 30)},
+	}
+
+	for _, tc := range tests {
+		got, err := ParseRelative(tc.expr, now)
+		if err != nil {
+			t.Errorf("ParseRelative(%q) error = %v", tc.expr, err)
+			continue
+		}
+		if !got.IsInstant() || got.Start != tc.want {
+			t.Errorf("ParseRelative(%q) = %v - %v, want %v", tc.expr, got.Start, got.End, tc.want)
+		}
+	}
+}
+
+func TestParseRelativeInterval(t *testing.T) {
+	now := Date(1402, Mordad, 20, 10, 30, 0, 0, time.UTC)
+	day := func(year int, month Month, day int) JalaliTime {
+		return Date(year, month, day, 0, 0, 0, 0, time.UTC)
+	}
+
+	tests := []struct {
+		expr       string
+		start, end JalaliTime
+	}{
+		{"هفته بعد", day(1402, Mordad, 21), day(1402, Mordad, 28)},
+		{"next week", day(1402, Mordad, 21), day(1402, Mordad, 28)},
+		{"این هفته", day(1402, Mordad, 14), day(1402, Mordad, 21)},
+		{"ماه آینده", day(1402, Shahrivar, 1), day(1402, Mehr, 1)},
+		{"last month", day(1402, Tir, 1), day(1402, Mordad, 1)},
+		{"امسال", day(1402, Farvardin, 1), day(1403, Farvardin, 1)},
+		{"پارسال", day(1401, Farvardin, 1), day(1402, Farvardin, 1)},
+		{"next year", day(1403, Farvardin, 1), day(1404, Farvardin, 1)},
+		{"اسفند", day(1402, Esfand, 1), day(1403, Farvardin, 1)},
+		{"روز بعد", day(1402, Mordad, 21), day(1402, Mordad, 22)},
+	}
+
+	for _, tc := range tests {
+		got, err := ParseRelative(tc.expr, now)
+		if err != nil {
+			t.Errorf("ParseRelative(%q) error = %v", tc.expr, err)
+			continue
+		}
+		if got.Start != tc.start || got.End != tc.end {
+			t.Errorf("ParseRelative(%q) = %v - %v, want %v - %v", tc.expr, got.Start, got.End, tc.start, tc.end)
+		}
+	}
+}
+
+func TestParseRelativeErrors(t *testing.T) {
+	now := Date(1402, Mordad, 20, 10, 30, 0, 0, time.UTC)
+	for _, expr := range []string{"", "فردا صبح", "۳ روز", "ساعت بعد", "بعد", "30 Esfand", "--7d", "7x", "7 d",
+		"999999999h", "999999999 ساعت دیگر", "in 999999999 minutes", "999999999d", "-999999999w", "999999999m", "999999999y"} {
+		if _, err := ParseRelative(expr, now); !errors.Is(err, ErrRelativeDate) {
+			t.Errorf("ParseRelative(%q) error = %v, want ErrRelativeDate", expr, err)
+		}
+	}
+}
+
+func TestIntervalContains(t *testing.T) {
+	i := Interval{Date(1402, Mordad, 1, 0, 0, 0, 0, time.UTC), Date(1402, Shahrivar, 1, 0, 0, 0, 0, time.UTC)}
+	if !i.Contains(Date(1402, Mordad, 31, 23, 59, 0, 0, time.UTC)) || i.Contains(i.End) || !i.Contains(i.Start) {
+		t.Errorf("Contains() is wrong for %v - %v", i.Start, i.End)
+	}
+	if p := (Interval{i.Start, i.Start}); !p.Contains(i.Start) || p.Contains(i.End) {
+		t.Error("Contains() is wrong for an instant")
+	}
+}
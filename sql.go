@@ -0,0 +1,108 @@
+/*
+MIT License
+
+Copyright (c) 2023 Mohammad Shafiee <muhammad.shafiee@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+
+package jalali
+
+import (
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// sqlLayouts are the layouts Scan accepts for text columns: those of ParseAny,
+// and the text form of timestamps with a zone offset written by databases.
+var sqlLayouts = append(append([]string{}, DefaultLayouts...), "%Y-%m-%d %H:%M:%S%z")
+
+// Scan implements the sql.Scanner interface. It accepts time.Time values, and
+// text holding a Jalali or Gregorian date in any of DefaultLayouts, such as
+// "1402/05/20" or "2023-08-11 13:00:45". The calendar of text is detected as by
+// ParseAnyCalendar, and text that reads as well in both calendars is rejected.
+// Text without a zone offset is read in the local time zone. NULL cannot be
+// scanned into a JalaliTime; use NullJalaliTime for nullable columns.
+func (j *JalaliTime) Scan(src any) error {
+	switch v := src.(type) {
+	case time.Time:
+		if v.IsZero() {
+			*j = JalaliTime{}
+			return nil
+		}
+		*j = ToJalali(v)
+		return nil
+	case string:
+		return j.scanText(v)
+	case []byte:
+		return j.scanText(string(v))
+	case nil:
+		return errors.New("JalaliTime.Scan: converting NULL to JalaliTime is unsupported")
+	}
+	return fmt.Errorf("JalaliTime.Scan: unsupported type %T", src)
+}
+
+// scanText parses a Jalali or Gregorian date read from a text column.
+func (j *JalaliTime) scanText(value string) error {
+	guess, err := (&AnyParser{Layouts: sqlLayouts}).ParseCalendar(value, time.Local)
+	if err != nil {
+		return fmt.Errorf("JalaliTime.Scan: %w", err)
+	}
+	if guess.Ambiguous {
+		return fmt.Errorf("JalaliTime.Scan: %q could be a Jalali or a Gregorian date", value)
+	}
+	*j = guess.Time
+	return nil
+}
+
+// Value implements the driver.Valuer interface. It returns the time as a
+// time.Time, or NULL for the zero JalaliTime.
+func (j JalaliTime) Value() (driver.Value, error) {
+	if j.IsZero() {
+		return nil, nil
+	}
+	return j.ToTime(), nil
+}
+
+// NullJalaliTime represents a JalaliTime that may be null. It implements the
+// sql.Scanner and driver.Valuer interfaces, like sql.NullTime.
+type NullJalaliTime struct {
+	Time  JalaliTime
+	Valid bool // Valid is true if Time is not NULL
+}
+
+// Scan implements the sql.Scanner interface.
+func (n *NullJalaliTime) Scan(src any) error {
+	if src == nil {
+		n.Time, n.Valid = JalaliTime{}, false
+		return nil
+	}
+	n.Valid = true
+	return n.Time.Scan(src)
+}
+
+// Value implements the driver.Valuer interface.
+func (n NullJalaliTime) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+	return n.Time.ToTime(), nil
+}
@@ -0,0 +1,177 @@
+/*
+MIT License
+
+Copyright (c) 2023 Mohammad Shafiee <muhammad.shafiee@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+
+package jalali
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"sync"
+	"testing"
+	"time"
+)
+
+// fakeDriver is an in-memory database/sql driver holding a single column. The
+// query "insert" appends its argument to the column and "select" returns it.
+type fakeDriver struct {
+	mu   sync.Mutex
+	rows []driver.Value
+}
+
+type fakeConn struct{ d *fakeDriver }
+
+type fakeStmt struct {
+	c     *fakeConn
+	query string
+}
+
+type fakeRows struct {
+	rows []driver.Value
+}
+
+func (d *fakeDriver) Open(name string) (driver.Conn, error) { return &fakeConn{d}, nil }
+
+func (c *fakeConn) Prepare(query string) (driver.Stmt, error) { return &fakeStmt{c, query}, nil }
+func (c *fakeConn) Close() error                              { return nil }
+func (c *fakeConn) Begin() (driver.Tx, error)                 { return nil, errors.New("fake: no transactions") }
+
+func (s *fakeStmt) Close() error  { return nil }
+func (s *fakeStmt) NumInput() int { return -1 }
+
+func (s *fakeStmt) Exec(args []driver.Value) (driver.Result, error) {
+	if s.query != "insert" || len(args) != 1 {
+		return nil, errors.New("fake: unsupported statement")
+	}
+	s.c.d.mu.Lock()
+	defer s.c.d.mu.Unlock()
+	s.c.d.rows = append(s.c.d.rows, args[0])
+	return driver.RowsAffected(1), nil
+}
+
+func (s *fakeStmt) Query(args []driver.Value) (driver.Rows, error) {
+	if s.query != "select" {
+		return nil, errors.New("fake: unsupported query")
+	}
+	s.c.d.mu.Lock()
+	defer s.c.d.mu.Unlock()
+	return &fakeRows{append([]driver.Value{}, s.c.d.rows...)}, nil
+}
+
+func (r *fakeRows) Columns() []string { return []string{"at"} }
+func (r *fakeRows) Close() error      { return nil }
+
+func (r *fakeRows) Next(dest []driver.Value) error {
+	if len(r.rows) == 0 {
+		return io.EOF
+	}
+	dest[0], r.rows = r.rows[0], r.rows[1:]
+	return nil
+}
+
+var fakeDB = &fakeDriver{}
+
+func init() {
+	sql.Register("jalalifake", fakeDB)
+}
+
+func TestJalaliTimeSQL(t *testing.T) {
+	db, err := sql.Open("jalalifake", "")
+	if err != nil {
+		t.Fatalf("Open() error = %v", err)
+	}
+	defer db.Close()
+	fakeDB.rows = nil
+
+	tehran := time.FixedZone("IRST", 12600)
+	j := Date(1402, Mordad, 20, 16, 30, 45, 0, tehran)
+	for _, arg := range []any{
+		j,
+		"1402/05/20",
+		[]byte("2023-08-11 13:00:45+0000"),
+		"2023-08-11T13:00:45Z",
+		NullJalaliTime{j, true},
+		NullJalaliTime{},
+		JalaliTime{},
+	} {
+		if _, err := db.Exec("insert", arg); err != nil {
+			t.Fatalf("Exec(%v) error = %v", arg, err)
+		}
+	}
+	if v, ok := fakeDB.rows[0].(time.Time); !ok || !v.Equal(j.ToTime()) {
+		t.Errorf("Value() stored %#v, want a time.Time", fakeDB.rows[0])
+	}
+
+	rows, err := db.Query("select")
+	if err != nil {
+		t.Fatalf("Query() error = %v", err)
+	}
+	defer rows.Close()
+
+	var got []NullJalaliTime
+	for rows.Next() {
+		var n NullJalaliTime
+		if err := rows.Scan(&n); err != nil {
+			t.Fatalf("Scan() error = %v", err)
+		}
+		got = append(got, n)
+	}
+	if err := rows.Err(); err != nil {
+		t.Fatalf("rows.Err() = %v", err)
+	}
+
+	if len(got) != 7 {
+		t.Fatalf("got %d rows, want 7", len(got))
+	}
+	for i := 0; i < 5; i++ {
+		if !got[i].Valid || got[i].Time.Year() != 1402 || got[i].Time.Month() != Mordad || got[i].Time.Day() != 20 {
+			t.Errorf("row %d = %+v, want 1402/05/20", i, got[i])
+		}
+	}
+	for _, i := range []int{0, 2, 3, 4} {
+		if !sameInstant(got[i].Time, j) {
+			t.Errorf("row %d = %v, want the instant of %v", i, got[i].Time, j)
+		}
+	}
+	if got[5].Valid || got[6].Valid {
+		t.Errorf("rows 5 and 6 = %+v, %+v, want NULL", got[5], got[6])
+	}
+}
+
+func TestJalaliTimeScanErrors(t *testing.T) {
+	var j JalaliTime
+	for _, src := range []any{nil, 42, "not a date", "05/06/1402", "2300/01/01"} {
+		if err := j.Scan(src); err == nil {
+			t.Errorf("Scan(%#v) error = nil, want an error", src)
+		}
+	}
+
+	if err := j.Scan(time.Time{}); err != nil || !j.IsZero() {
+		t.Errorf("Scan(time.Time{}) = %v, %v, want the zero JalaliTime", j, err)
+	}
+	if v, err := (JalaliTime{}).Value(); v != nil || err != nil {
+		t.Errorf("Value() of the zero JalaliTime = %v, %v, want nil", v, err)
+	}
+}
@@ -0,0 +1,229 @@
+/*
+MIT License
+
+Copyright (c) 2023 Mohammad Shafiee <muhammad.shafiee@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+
+package jalali
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// TimeOfDay is a wall clock time without a date or a location, such as the
+// opening time of a shop. TimeOfDay values can be compared with ==.
+type TimeOfDay struct {
+	Hour       int
+	Minute     int
+	Second     int
+	Nanosecond int
+}
+
+// TimeOfDay returns the wall clock time of j.
+func (j JalaliTime) TimeOfDay() TimeOfDay {
+	return TimeOfDay{j.hour, j.min, j.sec, j.nsec}
+}
+
+// ParseTimeOfDay parses a time of day formatted according to layout, such as
+// "%H:%M" or "%I:%M %p". Numbers may be written in Persian digits, and %p
+// matches "صبح" and "عصر". Date and zone fields in the layout are read but
+// ignored. See ParseInLocation.
+func ParseTimeOfDay(layout, value string) (TimeOfDay, error) {
+	j, err := ParseInLocation(layout, value, time.UTC)
+	if err != nil {
+		return TimeOfDay{}, err
+	}
+	return j.TimeOfDay(), nil
+}
+
+// IsValid reports whether t is a time of day between 00:00 and 23:59:59.999999999.
+func (t TimeOfDay) IsValid() bool {
+	return t.Hour >= 0 && t.Hour <= 23 && t.Minute >= 0 && t.Minute <= 59 &&
+		t.Second >= 0 && t.Second <= 59 && t.Nanosecond >= 0 && t.Nanosecond <= 999999999
+}
+
+// Compare returns -1 if t is before u, 0 if they are equal and +1 if t is after u.
+func (t TimeOfDay) Compare(u TimeOfDay) int {
+	switch {
+	case t.Hour != u.Hour:
+		return compareInt(t.Hour, u.Hour)
+	case t.Minute != u.Minute:
+		return compareInt(t.Minute, u.Minute)
+	case t.Second != u.Second:
+		return compareInt(t.Second, u.Second)
+	}
+	return compareInt(t.Nanosecond, u.Nanosecond)
+}
+
+// Before reports whether t is before u.
+func (t TimeOfDay) Before(u TimeOfDay) bool {
+	return t.Compare(u) < 0
+}
+
+// After reports whether t is after u.
+func (t TimeOfDay) After(u TimeOfDay) bool {
+	return t.Compare(u) > 0
+}
+
+// Format returns t formatted according to layout, which should only use the
+// time of day specifiers of JalaliTime.Format: %H, %I, %M, %S, %f, %p, %R and %T.
+func (t TimeOfDay) Format(layout string) string {
+	return t.on(JalaliDate{1, Farvardin, 1}).Format(layout)
+}
+
+// FormatLocale is like Format, but writes the AM/PM markers of the given locale.
+func (t TimeOfDay) FormatLocale(layout string, l *Locale) string {
+	return t.on(JalaliDate{1, Farvardin, 1}).FormatLocale(layout, l)
+}
+
+// String returns t in the form "16:30:00".
+func (t TimeOfDay) String() string {
+	return t.Format("%T")
+}
+
+// FaString returns t on a 12-hour clock in Persian digits, such as "۰۴:۳۰ عصر".
+func (t TimeOfDay) FaString() string {
+	return ToPersianDigits(t.Format("%I:%M %p"))
+}
+
+// on returns t on the day d in UTC.
+func (t TimeOfDay) on(d JalaliDate) JalaliTime {
+	return JalaliTime{d.Year, d.Month, d.Day, t.Hour, t.Minute, t.Second, t.Nanosecond, time.UTC}
+}
+
+// DSTPolicy chooses the time JalaliDate.AtPolicy returns for a wall clock time
+// that does not exist, because clocks were set forward over it, or that exists
+// twice, because clocks were set back over it.
+type DSTPolicy int
+
+const (
+	// DSTCompatible moves a skipped time forward by the length of the gap and
+	// takes the earlier of two repeated times. It is the policy of At.
+	DSTCompatible DSTPolicy = iota
+	// DSTEarlier moves a skipped time back by the length of the gap and takes
+	// the earlier of two repeated times.
+	DSTEarlier
+	// DSTLater moves a skipped time forward by the length of the gap and takes
+	// the later of two repeated times.
+	DSTLater
+	// DSTReject returns an error for skipped and repeated times.
+	DSTReject
+)
+
+// ErrSkippedTime is returned, wrapped, by AtPolicy with DSTReject for a wall
+// clock time that does not exist in the location.
+var ErrSkippedTime = errors.New("time skipped by a clock change")
+
+// ErrRepeatedTime is returned, wrapped, by AtPolicy with DSTReject for a wall
+// clock time that exists twice in the location.
+var ErrRepeatedTime = errors.New("time repeated by a clock change")
+
+// At returns the time t on the day d in the given location, resolving clock
+// changes with DSTCompatible. It panics if d or t is invalid.
+func (d JalaliDate) At(t TimeOfDay, loc *time.Location) JalaliTime {
+	j, err := d.AtPolicy(t, loc, DSTCompatible)
+	if err != nil {
+		panic(err)
+	}
+	return j
+}
+
+// AtPolicy returns the time t on the day d in the given location, resolving
+// times skipped or repeated by clock changes with the given policy. Since a
+// JalaliTime holds a wall clock time and a location, a repeated time that the
+// location itself would not choose for that wall clock is returned in a fixed
+// zone with the zone name and offset in effect at that instant.
+func (d JalaliDate) AtPolicy(t TimeOfDay, loc *time.Location, policy DSTPolicy) (JalaliTime, error) {
+	if !d.IsValid() {
+		return JalaliTime{}, fmt.Errorf("%w: %v", ErrInvalidDate, d)
+	}
+	if !t.IsValid() {
+		return JalaliTime{}, fmt.Errorf("invalid time of day: %02d:%02d:%02d.%09d", t.Hour, t.Minute, t.Second, t.Nanosecond)
+	}
+
+	// The wall clock time read as UTC, and the zone offsets in effect half a day
+	// before and after it. Clock changes are further apart than that.
+	wall := t.on(d).ToTime()
+	offsetAt := func(u time.Time) int {
+		_, offset := u.In(loc).Zone()
+		return offset
+	}
+	before, after := offsetAt(wall.Add(-12*time.Hour)), offsetAt(wall.Add(12*time.Hour))
+	early := wall.Add(-time.Duration(before) * time.Second)
+	late := wall.Add(-time.Duration(after) * time.Second)
+	earlyValid, lateValid := offsetAt(early) == before, offsetAt(late) == after
+
+	var u time.Time
+	switch {
+	case before == after || (earlyValid && !lateValid):
+		u = early
+	case lateValid && !earlyValid:
+		u = late
+	case earlyValid && lateValid:
+		// The time is repeated; early and late are both instants with that wall clock.
+		switch policy {
+		case DSTReject:
+			return JalaliTime{}, fmt.Errorf("%w: %v %v in %v", ErrRepeatedTime, d, t, loc)
+		case DSTLater:
+			u = later(early, late)
+		default:
+			u = earlier(early, late)
+		}
+	default:
+		// The time is skipped. Reading it with the offset before the change gives
+		// an instant after the change, and with the offset after, one before it.
+		switch policy {
+		case DSTReject:
+			return JalaliTime{}, fmt.Errorf("%w: %v %v in %v", ErrSkippedTime, d, t, loc)
+		case DSTEarlier:
+			u = late
+		default:
+			u = early
+		}
+	}
+
+	// A JalaliTime holds a wall clock time and a location, so a repeated time
+	// that the location would not choose for that wall clock is kept in a fixed
+	// zone with its offset.
+	j := ToJalali(u.In(loc))
+	if name, offset := u.In(loc).Zone(); zoneOffset(j) != offset {
+		j.loc = time.FixedZone(name, offset)
+	}
+	return j, nil
+}
+
+// earlier returns the earlier of a and b.
+func earlier(a, b time.Time) time.Time {
+	if b.Before(a) {
+		return b
+	}
+	return a
+}
+
+// later returns the later of a and b.
+func later(a, b time.Time) time.Time {
+	if b.After(a) {
+		return b
+	}
+	return a
+}
@@ -0,0 +1,174 @@
+/*
+MIT License
+
+Copyright (c) 2023 Mohammad Shafiee <muhammad.shafiee@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+
+package jalali
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestTimeOfDayFormat(t *testing.T) {
+	tests := []struct {
+		tod    TimeOfDay
+		layout string
+		want   string
+	}{
+		{TimeOfDay{16, 30, 0, 0}, "%H:%M", "16:30"},
+		{TimeOfDay{16, 30, 0, 0}, "%I:%M %p", "04:30 عصر"},
+		{TimeOfDay{0, 5, 0, 0}, "%I:%M %p", "12:05 صبح"},
+		{TimeOfDay{12, 0, 0, 0}, "%I %p", "12 عصر"},
+		{TimeOfDay{9, 5, 7, 500}, "%T.%f", "09:05:07.000000500"},
+	}
+	for _, tc := range tests {
+		if got := tc.tod.Format(tc.layout); got != tc.want {
+			t.Errorf("%v.Format(%q) = %q, want %q", tc.tod, tc.layout, got, tc.want)
+		}
+	}
+
+	tod := TimeOfDay{16, 30, 0, 0}
+	if got := tod.String(); got != "16:30:00" {
+		t.Errorf("String() = %q", got)
+	}
+	if got := tod.FaString(); got != "۰۴:۳۰ عصر" {
+		t.Errorf("FaString() = %q", got)
+	}
+	if got := tod.FormatLocale("%I:%M %p", LocaleEnglish); got != "04:30 PM" {
+		t.Errorf("FormatLocale() = %q", got)
+	}
+	if got := Date(1402, Mordad, 20, 16, 30, 0, 0, time.UTC).TimeOfDay(); got != tod {
+		t.Errorf("TimeOfDay() = %v, want %v", got, tod)
+	}
+}
+
+func TestParseTimeOfDay(t *testing.T) {
+	tests := []struct {
+		layout, value string
+		want          TimeOfDay
+	}{
+		{"%H:%M", "16:30", TimeOfDay{16, 30, 0, 0}},
+		{"%H:%M", "۱۶:۳۰", TimeOfDay{16, 30, 0, 0}},
+		{"%I:%M %p", "۴:۳۰ عصر", TimeOfDay{16, 30, 0, 0}},
+		{"%I:%M %p", "12:15 صبح", TimeOfDay{0, 15, 0, 0}},
+		{"%I:%M %p", "12:15 عصر", TimeOfDay{12, 15, 0, 0}},
+		{"%T.%f", "08:00:01.25", TimeOfDay{8, 0, 1, 250000000}},
+	}
+	for _, tc := range tests {
+		got, err := ParseTimeOfDay(tc.layout, tc.value)
+		if err != nil || got != tc.want {
+			t.Errorf("ParseTimeOfDay(%q, %q) = %v, %v, want %v", tc.layout, tc.value, got, err, tc.want)
+		}
+		if back, err := ParseTimeOfDay(tc.layout, got.Format(tc.layout)); err != nil || back != got {
+			t.Errorf("ParseTimeOfDay(Format(%v)) = %v, %v", got, back, err)
+		}
+	}
+
+	for _, value := range []string{"13:00 عصر", "0:00 صبح", "24:00"} {
+		layout := "%I:%M %p"
+		if value == "24:00" {
+			layout = "%H:%M"
+		}
+		if _, err := ParseTimeOfDay(layout, value); err == nil {
+			t.Errorf("ParseTimeOfDay(%q, %q) error = nil, want an error", layout, value)
+		}
+	}
+}
+
+func TestTimeOfDayCompare(t *testing.T) {
+	open, close := TimeOfDay{Hour: 9}, TimeOfDay{Hour: 17, Minute: 30}
+	if !open.Before(close) || open.After(close) || close.Compare(open) != 1 || open.Compare(open) != 0 {
+		t.Error("comparison is wrong")
+	}
+	if (TimeOfDay{Hour: 9, Nanosecond: 1}).Compare(open) != 1 {
+		t.Error("Compare() ignores nanoseconds")
+	}
+	if (TimeOfDay{Hour: 24}).IsValid() || (TimeOfDay{Minute: -1}).IsValid() || !open.IsValid() {
+		t.Error("IsValid() is wrong")
+	}
+}
+
+func TestJalaliDateAt(t *testing.T) {
+	d := JalaliDate{1402, Mordad, 20}
+	if got := d.At(TimeOfDay{16, 30, 0, 0}, Tehran()); !got.Equal(Date(1402, Mordad, 20, 16, 30, 0, 0, Tehran())) {
+		t.Errorf("At() = %v", got)
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("At() with an invalid time did not panic")
+		}
+	}()
+	d.At(TimeOfDay{Hour: 25}, time.UTC)
+}
+
+func TestJalaliDateAtPolicy(t *testing.T) {
+	// Iran set clocks forward from 00:00 to 01:00 on 1400/01/02, and back from
+	// 24:00 to 23:00 on 1400/06/30.
+	tehran := Tehran()
+	skipped := JalaliDate{1400, Farvardin, 2}
+	repeated := JalaliDate{1400, Shahrivar, 30}
+	halfPast := TimeOfDay{Minute: 30}
+	elevenThirty := TimeOfDay{Hour: 23, Minute: 30}
+
+	tests := []struct {
+		d       JalaliDate
+		t       TimeOfDay
+		policy  DSTPolicy
+		want    JalaliDate
+		wantT   TimeOfDay
+		offset  int
+		wantErr error
+	}{
+		{skipped, halfPast, DSTCompatible, skipped, TimeOfDay{Hour: 1, Minute: 30}, 16200, nil},
+		{skipped, halfPast, DSTLater, skipped, TimeOfDay{Hour: 1, Minute: 30}, 16200, nil},
+		{skipped, halfPast, DSTEarlier, skipped.AddDays(-1), elevenThirty, 12600, nil},
+		{skipped, halfPast, DSTReject, JalaliDate{}, TimeOfDay{}, 0, ErrSkippedTime},
+		{repeated, elevenThirty, DSTCompatible, repeated, elevenThirty, 16200, nil},
+		{repeated, elevenThirty, DSTEarlier, repeated, elevenThirty, 16200, nil},
+		{repeated, elevenThirty, DSTLater, repeated, elevenThirty, 12600, nil},
+		{repeated, elevenThirty, DSTReject, JalaliDate{}, TimeOfDay{}, 0, ErrRepeatedTime},
+		{repeated, halfPast, DSTReject, repeated, halfPast, 16200, nil},
+	}
+
+	for _, tc := range tests {
+		got, err := tc.d.AtPolicy(tc.t, tehran, tc.policy)
+		if !errors.Is(err, tc.wantErr) {
+			t.Errorf("AtPolicy(%v, %v, %v) error = %v, want %v", tc.d, tc.t, tc.policy, err, tc.wantErr)
+			continue
+		}
+		if err == nil && (got.Date() != tc.want || got.TimeOfDay() != tc.wantT || zoneOffset(got) != tc.offset) {
+			t.Errorf("AtPolicy(%v, %v, %v) = %v at %d, want %v %v at %d", tc.d, tc.t, tc.policy, got, zoneOffset(got), tc.want, tc.wantT, tc.offset)
+		}
+	}
+
+	// The earlier of the repeated times is half an hour before the change.
+	got, _ := repeated.AtPolicy(elevenThirty, tehran, DSTEarlier)
+	if want := time.Date(2021, time.September, 21, 19, 0, 0, 0, time.UTC); !got.ToTime().Equal(want) {
+		t.Errorf("AtPolicy() with DSTEarlier = %v, want %v", got.ToTime().UTC(), want)
+	}
+	if _, err := (JalaliDate{1402, Esfand, 30}).AtPolicy(halfPast, tehran, DSTCompatible); !errors.Is(err, ErrInvalidDate) {
+		t.Errorf("AtPolicy() of an invalid date error = %v, want ErrInvalidDate", err)
+	}
+}
@@ -0,0 +1,128 @@
+/*
+MIT License
+
+Copyright (c) 2023 Mohammad Shafiee <muhammad.shafiee@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+
+package jalali
+
+import "strings"
+
+// faOnes contains the Persian words for the numbers 0 to 19.
+var faOnes = []string{
+	"صفر", "یک", "دو", "سه", "چهار", "پنج", "شش", "هفت", "هشت", "نه",
+	"ده", "یازده", "دوازده", "سیزده", "چهارده", "پانزده", "شانزده", "هفده", "هجده", "نوزده",
+}
+
+// faTens contains the Persian words for the multiples of ten, indexed by the tens digit.
+var faTens = []string{"", "", "بیست", "سی", "چهل", "پنجاه", "شصت", "هفتاد", "هشتاد", "نود"}
+
+// faHundreds contains the Persian words for the multiples of a hundred, indexed by the hundreds digit.
+var faHundreds = []string{"", "صد", "دویست", "سیصد", "چهارصد", "پانصد", "ششصد", "هفتصد", "هشتصد", "نهصد"}
+
+// faScales contains the Persian words for the powers of a thousand.
+var faScales = []string{"", "هزار", "میلیون", "میلیارد", "تریلیون", "کوادریلیون", "کوینتیلیون"}
+
+// PersianWords returns n written out in Persian words, e.g. 1402 is "یک هزار و چهارصد و دو".
+// As on cheques and legal documents, a thousand is written "یک هزار".
+func PersianWords(n int64) string {
+	if n == 0 {
+		return faOnes[0]
+	}
+
+	// Work with the magnitude as an unsigned value so that math.MinInt64 is handled.
+	u := uint64(n)
+	prefix := ""
+	if n < 0 {
+		u = uint64(-n)
+		prefix = "منفی "
+	}
+
+	var groups []string
+	for scale := 0; u > 0; scale++ {
+		if chunk := int(u % 1000); chunk != 0 {
+			words := persianWordsBelowThousand(chunk)
+			if scale > 0 {
+				words += " " + faScales[scale]
+			}
+			groups = append(groups, words)
+		}
+		u /= 1000
+	}
+
+	// The groups were collected from the least significant; join them in reverse.
+	for i, j := 0, len(groups)-1; i < j; i, j = i+1, j-1 {
+		groups[i], groups[j] = groups[j], groups[i]
+	}
+	return prefix + strings.Join(groups, " و ")
+}
+
+// PersianOrdinalWords returns n written out as a Persian ordinal, e.g. 20 is "بیستم",
+// 21 is "بیست و یکم" and 30 is "سی‌ام". The ordinal of 1 is written "یکم".
+func PersianOrdinalWords(n int64) string {
+	words := PersianWords(n)
+	switch {
+	case strings.HasSuffix(words, "سه"):
+		// سه becomes سوم rather than سهم.
+		return strings.TrimSuffix(words, "سه") + "سوم"
+	case strings.HasSuffix(words, "ی"):
+		// Words ending in ی, such as سی, take ‌ام after a zero-width non-joiner.
+		return words + "‌ام"
+	default:
+		return words + "م"
+	}
+}
+
+// persianWordsBelowThousand returns the Persian words for a number between 1 and 999.
+func persianWordsBelowThousand(n int) string {
+	var parts []string
+	if h := n / 100; h > 0 {
+		parts = append(parts, faHundreds[h])
+	}
+	if r := n % 100; r >= 20 {
+		parts = append(parts, faTens[r/10])
+		if r%10 != 0 {
+			parts = append(parts, faOnes[r%10])
+		}
+	} else if r > 0 {
+		parts = append(parts, faOnes[r])
+	}
+	return strings.Join(parts, " و ")
+}
+
+// FormatWords returns the date of the JalaliTime written out in Persian words, as
+// required on cheques and contracts, e.g. "بیستم مرداد یک هزار و چهارصد و دو".
+func (j JalaliTime) FormatWords() string {
+	return j.Format("%Od %B %OY")
+}
+
+// ToPersianDigits returns s with the ASCII digits 0-9 replaced by the Persian digits ۰-۹.
+func ToPersianDigits(s string) string {
+	var builder strings.Builder
+	builder.Grow(len(s))
+	for _, r := range s {
+		if r >= '0' && r <= '9' {
+			r = '۰' + (r - '0')
+		}
+		builder.WriteRune(r)
+	}
+	return builder.String()
+}
@@ -0,0 +1,103 @@
+/*
+MIT License
+
+Copyright (c) 2023 Mohammad Shafiee <muhammad.shafiee@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+
+package jalali
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestPersianWords(t *testing.T) {
+	testCases := []struct {
+		n    int64
+		want string
+	}{
+		{0, "صفر"},
+		{7, "هفت"},
+		{15, "پانزده"},
+		{20, "بیست"},
+		{99, "نود و نه"},
+		{100, "صد"},
+		{205, "دویست و پنج"},
+		{1000, "یک هزار"},
+		{1300, "یک هزار و سیصد"},
+		{1402, "یک هزار و چهارصد و دو"},
+		{1999, "یک هزار و نهصد و نود و نه"},
+		{2723, "دو هزار و هفتصد و بیست و سه"},
+		{9999, "نه هزار و نهصد و نود و نه"},
+		{1000000, "یک میلیون"},
+		{2001030, "دو میلیون و یک هزار و سی"},
+		{-12, "منفی دوازده"},
+		{math.MaxInt64, "نه کوینتیلیون و دویست و بیست و سه کوادریلیون و سیصد و هفتاد و دو تریلیون و سی و شش میلیارد و هشتصد و پنجاه و چهار میلیون و هفتصد و هفتاد و پنج هزار و هشتصد و هفت"},
+	}
+
+	for _, tc := range testCases {
+		if got := PersianWords(tc.n); got != tc.want {
+			t.Errorf("PersianWords(%d) = %v, want %v", tc.n, got, tc.want)
+		}
+	}
+}
+
+func TestPersianOrdinalWords(t *testing.T) {
+	testCases := []struct {
+		n    int64
+		want string
+	}{
+		{1, "یکم"},
+		{2, "دوم"},
+		{3, "سوم"},
+		{10, "دهم"},
+		{13, "سیزدهم"},
+		{20, "بیستم"},
+		{21, "بیست و یکم"},
+		{23, "بیست و سوم"},
+		{30, "سی‌ام"},
+		{31, "سی و یکم"},
+		{100, "صدم"},
+		{1000, "یک هزارم"},
+	}
+
+	for _, tc := range testCases {
+		if got := PersianOrdinalWords(tc.n); got != tc.want {
+			t.Errorf("PersianOrdinalWords(%d) = %v, want %v", tc.n, got, tc.want)
+		}
+	}
+}
+
+func TestFormatWords(t *testing.T) {
+	j := Date(1402, Mordad, 20, 0, 0, 0, 0, time.UTC)
+	if got, want := j.FormatWords(), "بیستم مرداد یک هزار و چهارصد و دو"; got != want {
+		t.Errorf("FormatWords() = %v, want %v", got, want)
+	}
+
+	j = Date(1399, Esfand, 30, 0, 0, 0, 0, time.UTC)
+	if got, want := j.Format("%Od %B %Y"), "سی‌ام اسفند 1399"; got != want {
+		t.Errorf("Format(%%Od) = %v, want %v", got, want)
+	}
+	if got, want := j.Format("%O%%"), "%O%"; got != want {
+		t.Errorf("Format(%%O) = %v, want %v", got, want)
+	}
+}
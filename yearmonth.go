@@ -0,0 +1,245 @@
+/*
+MIT License
+
+Copyright (c) 2023 Mohammad Shafiee <muhammad.shafiee@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+
+package jalali
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// YearMonthLayout is the layout of YearMonth's String, text, JSON and SQL forms.
+const YearMonthLayout = "%Y-%m" // 1402-05
+
+// YearMonth is a month of the Jalali calendar, such as a monthly billing
+// period. YearMonth values can be compared with ==.
+type YearMonth struct {
+	Year  int
+	Month Month
+}
+
+// YearMonth returns the month of j.
+func (j JalaliTime) YearMonth() YearMonth {
+	return YearMonth{j.year, j.month}
+}
+
+// YearMonth returns the month of d.
+func (d JalaliDate) YearMonth() YearMonth {
+	return YearMonth{d.Year, d.Month}
+}
+
+// ParseYearMonth parses a month formatted according to layout, such as
+// YearMonthLayout or "%B %Y". See ParseInLocation.
+func ParseYearMonth(layout, value string) (YearMonth, error) {
+	j, err := ParseInLocation(layout, value, time.UTC)
+	if err != nil {
+		return YearMonth{}, err
+	}
+	return j.YearMonth(), nil
+}
+
+// IsValid reports whether m is a month of the Jalali calendar.
+func (m YearMonth) IsValid() bool {
+	return m.Year >= 1 && m.Month >= Farvardin && m.Month <= Esfand
+}
+
+// IsZero reports whether m is the zero YearMonth.
+func (m YearMonth) IsZero() bool {
+	return m == YearMonth{}
+}
+
+// Days returns the number of days in m.
+func (m YearMonth) Days() int {
+	return daysInMonth(m.Year, m.Month)
+}
+
+// First returns the first day of m.
+func (m YearMonth) First() JalaliDate {
+	return JalaliDate{m.Year, m.Month, 1}
+}
+
+// Last returns the last day of m.
+func (m YearMonth) Last() JalaliDate {
+	return JalaliDate{m.Year, m.Month, m.Days()}
+}
+
+// Contains reports whether d is a day of m.
+func (m YearMonth) Contains(d JalaliDate) bool {
+	return d.YearMonth() == m
+}
+
+// AddMonths returns the month n months after m.
+func (m YearMonth) AddMonths(n int) YearMonth {
+	year, month := shiftMonth(m.Year, m.Month, n)
+	return YearMonth{year, month}
+}
+
+// Compare returns -1 if m is before u, 0 if they are the same month and +1 if m is after u.
+func (m YearMonth) Compare(u YearMonth) int {
+	if m.Year != u.Year {
+		return compareInt(m.Year, u.Year)
+	}
+	return compareInt(int(m.Month), int(u.Month))
+}
+
+// Before reports whether m is before u.
+func (m YearMonth) Before(u YearMonth) bool {
+	return m.Compare(u) < 0
+}
+
+// After reports whether m is after u.
+func (m YearMonth) After(u YearMonth) bool {
+	return m.Compare(u) > 0
+}
+
+// Dates returns the days of m in order.
+func (m YearMonth) Dates() []JalaliDate {
+	dates := make([]JalaliDate, m.Days())
+	for i := range dates {
+		dates[i] = JalaliDate{m.Year, m.Month, i + 1}
+	}
+	return dates
+}
+
+// Weeks returns the weeks, from Saturday to Friday, that overlap m, as for a
+// calendar page. The first and last weeks include days of the adjacent months.
+func (m YearMonth) Weeks() [][7]JalaliDate {
+	// Saturday is day 0 of the week.
+	start := m.First().AddDays(-((int(m.First().Weekday()) + 1) % 7))
+	last := m.Last()
+
+	var weeks [][7]JalaliDate
+	for !start.After(last) {
+		var week [7]JalaliDate
+		for i := range week {
+			week[i] = start.AddDays(i)
+		}
+		weeks = append(weeks, week)
+		start = start.AddDays(7)
+	}
+	return weeks
+}
+
+// Format returns m formatted according to layout, which should only use the
+// year and month specifiers of JalaliTime.Format.
+func (m YearMonth) Format(layout string) string {
+	return m.First().Format(layout)
+}
+
+// FormatLocale is like Format, but uses the names and era of the given locale.
+func (m YearMonth) FormatLocale(layout string, l *Locale) string {
+	return m.First().FormatLocale(layout, l)
+}
+
+// String returns m in the form "1402-05".
+func (m YearMonth) String() string {
+	return m.Format(YearMonthLayout)
+}
+
+// FaString returns the Persian month name and the year in Persian digits, such as "مرداد ۱۴۰۲".
+func (m YearMonth) FaString() string {
+	return ToPersianDigits(m.Format("%B %Y"))
+}
+
+// MarshalText implements the encoding.TextMarshaler interface. The month is
+// written in YearMonthLayout; the zero YearMonth is empty.
+func (m YearMonth) MarshalText() ([]byte, error) {
+	if m.IsZero() {
+		return []byte{}, nil
+	}
+	return []byte(m.String()), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+func (m *YearMonth) UnmarshalText(data []byte) error {
+	if len(data) == 0 {
+		*m = YearMonth{}
+		return nil
+	}
+	parsed, err := ParseYearMonth(YearMonthLayout, string(data))
+	if err != nil {
+		return err
+	}
+	*m = parsed
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaler interface. The month is a quoted
+// string in YearMonthLayout; the zero YearMonth is null.
+func (m YearMonth) MarshalJSON() ([]byte, error) {
+	if m.IsZero() {
+		return []byte("null"), nil
+	}
+	return json.Marshal(m.String())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface. As with time.Time,
+// null is a no-op.
+func (m *YearMonth) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return fmt.Errorf("YearMonth: expected a JSON string, got %s", data)
+	}
+	return m.UnmarshalText([]byte(s))
+}
+
+// Scan implements the sql.Scanner interface. It accepts text in
+// YearMonthLayout, such as "1402-05", and the values JalaliTime.Scan accepts,
+// whose month it keeps.
+func (m *YearMonth) Scan(src any) error {
+	var text string
+	switch v := src.(type) {
+	case string:
+		text = v
+	case []byte:
+		text = string(v)
+	}
+	if text != "" {
+		if parsed, err := ParseYearMonth(YearMonthLayout, text); err == nil {
+			*m = parsed
+			return nil
+		}
+	}
+
+	var j JalaliTime
+	if err := j.Scan(src); err != nil {
+		return err
+	}
+	*m = j.YearMonth()
+	return nil
+}
+
+// Value implements the driver.Valuer interface. It returns m as text in
+// YearMonthLayout, or NULL for the zero YearMonth.
+func (m YearMonth) Value() (driver.Value, error) {
+	if m.IsZero() {
+		return nil, nil
+	}
+	return m.String(), nil
+}
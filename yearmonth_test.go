@@ -0,0 +1,163 @@
+/*
+MIT License
+
+Copyright (c) 2023 Mohammad Shafiee <muhammad.shafiee@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+
+package jalali
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestYearMonth(t *testing.T) {
+	m := Date(1402, Mordad, 20, 16, 30, 0, 0, time.UTC).YearMonth()
+	if m != (YearMonth{1402, Mordad}) || (JalaliDate{1402, Mordad, 1}).YearMonth() != m {
+		t.Fatalf("YearMonth() = %v", m)
+	}
+	if m.Days() != 31 || (YearMonth{1402, Esfand}).Days() != 29 || (YearMonth{1403, Esfand}).Days() != 30 {
+		t.Error("Days() is wrong")
+	}
+	if m.First() != (JalaliDate{1402, Mordad, 1}) || m.Last() != (JalaliDate{1402, Mordad, 31}) {
+		t.Errorf("First(), Last() = %v, %v", m.First(), m.Last())
+	}
+	if !m.Contains(JalaliDate{1402, Mordad, 31}) || m.Contains(JalaliDate{1402, Shahrivar, 1}) {
+		t.Error("Contains() is wrong")
+	}
+	if !m.IsValid() || (YearMonth{1402, 13}).IsValid() || !(YearMonth{}).IsZero() {
+		t.Error("IsValid() or IsZero() is wrong")
+	}
+
+	if got := m.AddMonths(5); got != (YearMonth{1402, Dey}) {
+		t.Errorf("AddMonths(5) = %v", got)
+	}
+	if got := m.AddMonths(-5); got != (YearMonth{1401, Esfand}) {
+		t.Errorf("AddMonths(-5) = %v", got)
+	}
+	if m.Compare(YearMonth{1402, Mordad}) != 0 || !m.Before(YearMonth{1403, Farvardin}) || !m.After(YearMonth{1402, Tir}) {
+		t.Error("Compare(), Before() or After() is wrong")
+	}
+}
+
+func TestYearMonthFormat(t *testing.T) {
+	m := YearMonth{1402, Mordad}
+	if got := m.String(); got != "1402-05" {
+		t.Errorf("String() = %q", got)
+	}
+	if got := m.FaString(); got != "مرداد ۱۴۰۲" {
+		t.Errorf("FaString() = %q", got)
+	}
+	if got := m.Format("%B %Y"); got != "مرداد 1402" {
+		t.Errorf("Format() = %q", got)
+	}
+
+	for _, value := range []string{"1402-05", "1402-5", "۱۴۰۲-۰۵"} {
+		got, err := ParseYearMonth(YearMonthLayout, value)
+		if err != nil || got != m {
+			t.Errorf("ParseYearMonth(%q) = %v, %v", value, got, err)
+		}
+	}
+	if got, err := ParseYearMonth("%B %Y", "مرداد 1402"); err != nil || got != m {
+		t.Errorf("ParseYearMonth() = %v, %v", got, err)
+	}
+	if _, err := ParseYearMonth(YearMonthLayout, "1402-13"); err == nil {
+		t.Error("ParseYearMonth() accepted month 13")
+	}
+}
+
+func TestYearMonthDates(t *testing.T) {
+	m := YearMonth{1402, Mordad}
+	dates := m.Dates()
+	if len(dates) != 31 || dates[0] != m.First() || dates[30] != m.Last() {
+		t.Errorf("Dates() = %v", dates)
+	}
+
+	// Mordad 1402 starts on a Sunday and ends on a Tuesday.
+	weeks := m.Weeks()
+	if len(weeks) != 5 {
+		t.Fatalf("len(Weeks()) = %d, want 5", len(weeks))
+	}
+	if weeks[0][0] != (JalaliDate{1402, Tir, 31}) || weeks[0][1] != m.First() {
+		t.Errorf("first week = %v", weeks[0])
+	}
+	if weeks[4][3] != m.Last() || weeks[4][6] != (JalaliDate{1402, Shahrivar, 3}) {
+		t.Errorf("last week = %v", weeks[4])
+	}
+	for _, week := range weeks {
+		if week[0].Weekday() != Shanbe || week[6].Weekday() != Joomeh {
+			t.Errorf("week %v does not run from Saturday to Friday", week)
+		}
+	}
+
+	// Ordibehesht 1403 starts on a Saturday.
+	if weeks := (YearMonth{1403, Ordibehesht}).Weeks(); len(weeks) != 5 || weeks[0][0] != (JalaliDate{1403, Ordibehesht, 1}) {
+		t.Errorf("Weeks() = %v", weeks)
+	}
+}
+
+func TestYearMonthJSON(t *testing.T) {
+	type invoice struct {
+		Period YearMonth `json:"period"`
+		Prev   YearMonth `json:"prev"`
+	}
+	data, err := json.Marshal(invoice{Period: YearMonth{1402, Mordad}})
+	if err != nil || string(data) != `{"period":"1402-05","prev":null}` {
+		t.Fatalf("Marshal() = %s, %v", data, err)
+	}
+
+	var got invoice
+	if err := json.Unmarshal(data, &got); err != nil || got.Period != (YearMonth{1402, Mordad}) || !got.Prev.IsZero() {
+		t.Errorf("Unmarshal() = %v, %v", got, err)
+	}
+	if err := json.Unmarshal([]byte(`{"period":1402}`), &got); err == nil {
+		t.Error("Unmarshal() accepted a number")
+	}
+}
+
+func TestYearMonthSQL(t *testing.T) {
+	m := YearMonth{1402, Mordad}
+	if v, err := m.Value(); err != nil || v != driver.Value("1402-05") {
+		t.Errorf("Value() = %v, %v", v, err)
+	}
+	if v, err := (YearMonth{}).Value(); err != nil || v != nil {
+		t.Errorf("Value() of zero = %v, %v", v, err)
+	}
+
+	for _, src := range []any{
+		"1402-05",
+		[]byte("1402-05"),
+		"1402-05-20",
+		time.Date(2023, time.August, 11, 12, 0, 0, 0, time.UTC),
+	} {
+		var got YearMonth
+		if err := got.Scan(src); err != nil || got != m {
+			t.Errorf("Scan(%v) = %v, %v", src, got, err)
+		}
+	}
+
+	var got YearMonth
+	if err := got.Scan(42); err == nil {
+		t.Error("Scan() accepted an int")
+	}
+}